@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// NewSubmitUpdateReservedSubdenomsProposalCmd returns the command to submit an UpdateReservedSubdenomsProposal
+func NewSubmitUpdateReservedSubdenomsProposalCmd() *cobra.Command {
+	cmd := newSubmitProposalCmd(
+		"update-reserved-subdenoms",
+		"Submit a proposal to update the reserved subdenom patterns and the creators exempt from them",
+		cobra.NoArgs,
+		func(cmd *cobra.Command, args []string, title, description string) (govtypes.Content, error) {
+			addPatterns, err := cmd.Flags().GetStringSlice(FlagAddPatterns)
+			if err != nil {
+				return nil, err
+			}
+			removePatterns, err := cmd.Flags().GetStringSlice(FlagRemovePatterns)
+			if err != nil {
+				return nil, err
+			}
+			addExemptCreators, err := cmd.Flags().GetStringSlice(FlagAddExemptCreators)
+			if err != nil {
+				return nil, err
+			}
+			removeExemptCreators, err := cmd.Flags().GetStringSlice(FlagRemoveExemptCreators)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewUpdateReservedSubdenomsProposal(title, description, addPatterns, removePatterns, addExemptCreators, removeExemptCreators), nil
+		},
+	)
+
+	cmd.Flags().StringSlice(FlagAddPatterns, nil, "Reserved subdenom patterns to add")
+	cmd.Flags().StringSlice(FlagRemovePatterns, nil, "Reserved subdenom patterns to remove")
+	cmd.Flags().StringSlice(FlagAddExemptCreators, nil, "Creator addresses to exempt from the reserved subdenoms")
+	cmd.Flags().StringSlice(FlagRemoveExemptCreators, nil, "Creator addresses to no longer exempt from the reserved subdenoms")
+
+	return cmd
+}
+
+// NewSubmitDelistDenomProposalCmd returns the command to submit a DelistDenomProposal
+func NewSubmitDelistDenomProposalCmd() *cobra.Command {
+	return newSubmitProposalCmd(
+		"delist-denom [denom]",
+		"Submit a proposal to remove the admin of a denom and forfeit its creation deposit to the community pool",
+		cobra.ExactArgs(1),
+		func(cmd *cobra.Command, args []string, title, description string) (govtypes.Content, error) {
+			return types.NewDelistDenomProposal(title, description, args[0]), nil
+		},
+	)
+}
+
+// NewSubmitSetDenomAdminProposalCmd returns the command to submit a SetDenomAdminProposal
+func NewSubmitSetDenomAdminProposalCmd() *cobra.Command {
+	return newSubmitProposalCmd(
+		"set-denom-admin [denom] [new-admin]",
+		"Submit a proposal to reassign the admin of a denom, or to remove it if new-admin is empty",
+		cobra.ExactArgs(2),
+		func(cmd *cobra.Command, args []string, title, description string) (govtypes.Content, error) {
+			return types.NewSetDenomAdminProposal(title, description, args[0], args[1]), nil
+		},
+	)
+}
+
+// NewSubmitFreezeDenomProposalCmd returns the command to submit a FreezeDenomProposal
+func NewSubmitFreezeDenomProposalCmd() *cobra.Command {
+	cmd := newSubmitProposalCmd(
+		"freeze-denom [denom]",
+		"Submit a proposal to freeze a denom, disabling all admin actions on it until it is unfrozen",
+		cobra.ExactArgs(1),
+		func(cmd *cobra.Command, args []string, title, description string) (govtypes.Content, error) {
+			unfreeze, err := cmd.Flags().GetBool(FlagUnfreeze)
+			if err != nil {
+				return nil, err
+			}
+
+			return types.NewFreezeDenomProposal(title, description, args[0], !unfreeze), nil
+		},
+	)
+
+	cmd.Flags().Bool(FlagUnfreeze, false, "Unfreeze the denom instead of freezing it")
+
+	return cmd
+}
+
+// newSubmitProposalCmd returns a command that submits the proposal built by newContent, with
+// the title, description and deposit flags of the gov proposals
+func newSubmitProposalCmd(use, short string, args cobra.PositionalArgs, newContent func(cmd *cobra.Command, args []string, title, description string) (govtypes.Content, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := newContent(cmd, args, title, description)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
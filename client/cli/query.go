@@ -5,6 +5,7 @@ import (
 	// "strings"
 
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -27,6 +28,25 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomCreationRecord(),
+		GetCmdSubdenomAvailability(),
+		GetCmdReservedSubdenoms(),
+		GetCmdDenomDeposit(),
+		GetCmdTokenProfile(),
+		GetCmdVestingSchedules(),
+		GetCmdMintSchedules(),
+		GetCmdBalanceAtSnapshot(),
+		GetCmdSnapshotHolders(),
+		GetCmdPendingRewards(),
+		GetCmdHolderCount(),
+		GetCmdTopHolders(),
+		GetCmdConversionRoutes(),
+		GetCmdDenomBacking(),
+		GetCmdTransferFee(),
+		GetCmdAllowlist(),
+		GetCmdMaxBalance(),
+		GetCmdAllowance(),
+		GetCmdAllowances(),
 	)
 
 	return cmd
@@ -89,3 +109,605 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdDenomCreationRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-creation-record [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the creation record for a specific denom",
+		Long:  "Get the creator, creation height, creation time and creation fee paid for a specific denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomCreationRecord(cmd.Context(), &types.QueryDenomCreationRecordRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSubdenomAvailability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subdenom-availability [creator] [subdenom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Check whether a creator can create a denom with a specific subdenom",
+		Long:  "Check whether a creator can create a denom with a specific subdenom, and the reason if it can't",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SubdenomAvailability(cmd.Context(), &types.QuerySubdenomAvailabilityRequest{
+				Creator:  args[0],
+				Subdenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdReservedSubdenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserved-subdenoms",
+		Args:  cobra.NoArgs,
+		Short: "Get the reserved subdenom patterns and the creators exempt from them",
+		Long:  "Get the reserved subdenom patterns and the creators exempt from them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ReservedSubdenoms(cmd.Context(), &types.QueryReservedSubdenomsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdDenomDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-deposit [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the refundable creation deposit of a specific denom",
+		Long:  "Get the depositor and amount of the refundable creation deposit of a specific denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomDeposit(cmd.Context(), &types.QueryDenomDepositRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdTokenProfile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-profile [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the extended profile of a specific denom",
+		Long:  "Get the logo, website, description, tags and contact of a specific denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TokenProfile(cmd.Context(), &types.QueryTokenProfileRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdVestingSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedules [recipient]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the vesting schedules of a specific recipient",
+		Long:  "Get the vesting schedules of a specific recipient, with their vested, unvested and claimable amounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VestingSchedules(cmd.Context(), &types.QueryVestingSchedulesRequest{
+				Recipient: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdMintSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-schedules [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Get the pending mint schedules of a specific denom, or of all denoms",
+		Long:  "Get the pending mint schedules of a specific denom, or of all denoms if no denom is given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMintSchedulesRequest{Pagination: pageReq}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MintSchedules(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint-schedules")
+
+	return cmd
+}
+
+func GetCmdBalanceAtSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-at-snapshot [denom] [snapshot-id] [address]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Get the balance of an address at a snapshot of a denom",
+		Long:  "Get the balance of an address at a snapshot of a denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshotID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BalanceAtSnapshot(cmd.Context(), &types.QueryBalanceAtSnapshotRequest{
+				Denom:      args[0],
+				SnapshotId: snapshotID,
+				Address:    args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSnapshotHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot-holders [denom] [snapshot-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Get the holders of a denom at a snapshot, with their balances",
+		Long:  "Get the holders of a denom at a snapshot, with their balances",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshotID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SnapshotHolders(cmd.Context(), &types.QuerySnapshotHoldersRequest{
+				Denom:      args[0],
+				SnapshotId: snapshotID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "snapshot holders")
+
+	return cmd
+}
+
+func GetCmdPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [denom] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Get the distributed rewards of a holder of a denom that can be claimed",
+		Long:  "Get the distributed rewards of a holder of a denom that can be claimed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingRewards(cmd.Context(), &types.QueryPendingRewardsRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdHolderCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holder-count [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the number of holders of a denom with a holder index",
+		Long:  "Get the number of holders of a denom with a holder index",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.HolderCount(cmd.Context(), &types.QueryHolderCountRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdTopHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-holders [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the holders of a denom with a holder index, sorted by descending balance",
+		Long:  "Get the holders of a denom with a holder index, sorted by descending balance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TopHolders(cmd.Context(), &types.QueryTopHoldersRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "top-holders")
+
+	return cmd
+}
+
+func GetCmdConversionRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-routes",
+		Args:  cobra.NoArgs,
+		Short: "Get the conversion routes that can currently be used",
+		Long:  "Get the conversion routes that can currently be used",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConversionRoutes(cmd.Context(), &types.QueryConversionRoutesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdDenomBacking() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-backing [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the collateral backing of a specific denom",
+		Long:  "Get the collateral denom and the escrowed collateral amount of a specific backed denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomBacking(cmd.Context(), &types.QueryDenomBackingRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-fee [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the transfer fee of a specific denom",
+		Long:  "Get the basis points, recipient and exempt addresses of the transfer fee of a specific denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TransferFee(cmd.Context(), &types.QueryTransferFeeRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowlist [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get whether the transfers of a denom are restricted, and the addresses on its allowlist",
+		Long:  "Get whether the transfers of a denom are restricted, and the addresses on its allowlist",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Allowlist(cmd.Context(), &types.QueryAllowlistRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowlist")
+
+	return cmd
+}
+
+func GetCmdMaxBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "max-balance [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the max balance per address of a specific denom",
+		Long:  "Get the max balance per address and the exempt addresses of a specific denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MaxBalance(cmd.Context(), &types.QueryMaxBalanceRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance [denom] [owner] [spender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Get the allowance of a spender for the balance of an owner",
+		Long:  "Get the remaining amount of a denom that a spender can transfer from the balance of an owner, and its expiration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Allowance(cmd.Context(), &types.QueryAllowanceRequest{
+				Denom:   args[0],
+				Owner:   args[1],
+				Spender: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowances [denom] [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Get the allowances of the spenders of the balance of an owner",
+		Long:  "Get the allowances of the spenders of the balance of an owner, without the expired ones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Allowances(cmd.Context(), &types.QueryAllowancesRequest{
+				Denom:      args[0],
+				Owner:      args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowances")
+
+	return cmd
+}
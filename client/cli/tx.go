@@ -2,18 +2,101 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	// "github.com/cosmos/cosmos-sdk/client/flags"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/osmosis-labs/tokenfactory/types"
 )
 
+// flags for the set-denom-metadata command
+const (
+	FlagDisplay     = "display"
+	FlagSymbol      = "symbol"
+	FlagExponent    = "exponent"
+	FlagName        = "name"
+	FlagDescription = "description"
+)
+
+// flags for the grant and approve commands
+const (
+	FlagExpiration           = "expiration"
+	FlagAllowedRecipients    = "allowed-recipients"
+	FlagAllowedFromAddresses = "allowed-from-addresses"
+)
+
+// flags for the delete-denom command
+const (
+	FlagTombstone = "tombstone"
+)
+
+// flags for the gov-freeze-denom command
+const (
+	FlagUnfreeze = "unfreeze"
+)
+
+// flags for the lock-denom-metadata command
+const (
+	FlagPartial = "partial"
+)
+
+// flags for the set-token-profile command
+const (
+	FlagLogoURI     = "logo-uri"
+	FlagLogoURIHash = "logo-uri-hash"
+	FlagWebsite     = "website"
+	FlagTags        = "tags"
+	FlagContact     = "contact"
+)
+
+// flags for the mint-vesting command
+const (
+	FlagStartTime = "start-time"
+	FlagCliffTime = "cliff-time"
+	FlagEndTime   = "end-time"
+)
+
+// flags for the create-mint-schedule command
+const (
+	FlagPeriodBlocks  = "period-blocks"
+	FlagPeriodSeconds = "period-seconds"
+	FlagMaxMints      = "max-mints"
+	FlagDecayRate     = "decay-rate"
+)
+
+// flags for the register-conversion-route command
+const (
+	FlagDeadline = "deadline"
+)
+
+// flags for the set-transfer-fee and set-max-balance commands
+const (
+	FlagExemptAddresses = "exempt-addresses"
+)
+
+// flags for the update-reserved-subdenoms command
+const (
+	FlagAddPatterns          = "add"
+	FlagRemovePatterns       = "remove"
+	FlagAddExemptCreators    = "add-exempt-creators"
+	FlagRemoveExemptCreators = "remove-exempt-creators"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 
@@ -31,6 +114,38 @@ func GetTxCmd() *cobra.Command {
 		NewMintCmd(),
 		NewBurnCmd(),
 		NewChangeAdminCmd(),
+		NewSetDenomMetadataCmd(),
+		NewGrantMintCmd(),
+		NewGrantBurnCmd(),
+		NewGrantForceTransferCmd(),
+		NewUpdateReservedSubdenomsCmd(),
+		NewDelistDenomCmd(),
+		NewDeleteDenomCmd(),
+		NewGovSetDenomAdminCmd(),
+		NewGovFreezeDenomCmd(),
+		NewLockDenomMetadataCmd(),
+		NewSetTokenProfileCmd(),
+		NewMintVestingCmd(),
+		NewClaimVestedCmd(),
+		NewCreateMintScheduleCmd(),
+		NewCancelMintScheduleCmd(),
+		NewTakeSnapshotCmd(),
+		NewDepositDistributionCmd(),
+		NewClaimDistributionCmd(),
+		NewSetHolderIndexCmd(),
+		NewRegisterConversionRouteCmd(),
+		NewConvertCmd(),
+		NewSetDenomBackingCmd(),
+		NewWrapCmd(),
+		NewUnwrapCmd(),
+		NewSetTransferFeeCmd(),
+		NewSetRestrictedCmd(),
+		NewAddToAllowlistCmd(),
+		NewRemoveFromAllowlistCmd(),
+		NewSetMaxBalanceCmd(),
+		NewApproveCmd(),
+		NewTransferFromCmd(),
+		NewRevokeApprovalCmd(),
 	)
 
 	return cmd
@@ -152,3 +267,1347 @@ func NewChangeAdminCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewDeleteDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-denom [denom] [flags]",
+		Short: "Deletes a factory-created denom with a zero total supply and refunds its creation deposit. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			tombstone, err := cmd.Flags().GetBool(FlagTombstone)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteDenom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				tombstone,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagTombstone, false, "Prevent the denom from being created again")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUpdateReservedSubdenomsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-reserved-subdenoms",
+		Short: "Updates the reserved subdenom patterns and the creators exempt from them. Must be sent by the module authority.",
+		Long: `Updates the reserved subdenom patterns and the creators exempt from them. Must be sent by the module authority.
+A "*" in a pattern matches any sequence of characters, so "usd*" reserves every subdenom starting with "usd".`,
+		Example: fmt.Sprintf("%s tx %s update-reserved-subdenoms --add=usdc,atom,usd* --add-exempt-creators=[address] --from=[authority]", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			addPatterns, err := cmd.Flags().GetStringSlice(FlagAddPatterns)
+			if err != nil {
+				return err
+			}
+			removePatterns, err := cmd.Flags().GetStringSlice(FlagRemovePatterns)
+			if err != nil {
+				return err
+			}
+			addExemptCreators, err := cmd.Flags().GetStringSlice(FlagAddExemptCreators)
+			if err != nil {
+				return err
+			}
+			removeExemptCreators, err := cmd.Flags().GetStringSlice(FlagRemoveExemptCreators)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateReservedSubdenoms(
+				clientCtx.GetFromAddress().String(),
+				addPatterns,
+				removePatterns,
+				addExemptCreators,
+				removeExemptCreators,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddPatterns, nil, "Reserved subdenom patterns to add")
+	cmd.Flags().StringSlice(FlagRemovePatterns, nil, "Reserved subdenom patterns to remove")
+	cmd.Flags().StringSlice(FlagAddExemptCreators, nil, "Creator addresses to exempt from the reserved subdenoms")
+	cmd.Flags().StringSlice(FlagRemoveExemptCreators, nil, "Creator addresses to no longer exempt from the reserved subdenoms")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDelistDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-denom [denom]",
+		Short: "Removes the admin of a denom and forfeits its creation deposit to the community pool. Must be sent by the module authority.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgDelistDenom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewGovSetDenomAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-set-denom-admin [denom] [new-admin]",
+		Short: "Reassigns the admin of a denom, or removes it if new-admin is empty. Must be sent by the module authority.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgGovSetDenomAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewGovFreezeDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-freeze-denom [denom] [flags]",
+		Short: "Freezes a denom, disabling all admin actions on it until it is unfrozen. Must be sent by the module authority.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			unfreeze, err := cmd.Flags().GetBool(FlagUnfreeze)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGovFreezeDenom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				!unfreeze,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagUnfreeze, false, "Unfreeze the denom instead of freezing it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [denom]",
+		Short: "Builds and sets the bank metadata of a factory-created denom. Must have admin authority to do so.",
+		Long: `Builds the bank metadata of a factory-created denom from flags and sets it.
+The current metadata is fetched from the bank module, and only the fields
+given as flags are changed. The base unit is always kept first with exponent 0,
+and the display unit is added with the given exponent. The resulting metadata
+is validated and a diff against the current metadata is printed before the
+transaction is broadcast.
+
+Example:
+$ tx tokenfactory set-denom-metadata factory/{creator}/ufoo --display=factory/{creator}/ufoo/foo --exponent=6 --symbol=FOO --name="Foo Token"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			denom := args[0]
+			if _, _, err := types.DeconstructDenom(denom); err != nil {
+				return err
+			}
+
+			current := banktypes.Metadata{}
+			if !clientCtx.Offline {
+				res, err := banktypes.NewQueryClient(clientCtx).DenomMetadata(cmd.Context(), &banktypes.QueryDenomMetadataRequest{
+					Denom: denom,
+				})
+				if err != nil {
+					return err
+				}
+				current = res.Metadata
+			}
+
+			metadata, err := buildDenomMetadata(cmd, denom, current)
+			if err != nil {
+				return err
+			}
+
+			if err := metadata.Validate(); err != nil {
+				return err
+			}
+
+			printDenomMetadataDiff(cmd.ErrOrStderr(), current, metadata)
+
+			msg := types.NewMsgSetDenomMetadata(
+				clientCtx.GetFromAddress().String(),
+				metadata,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDisplay, "", "Display denom unit, namespaced under the base denom, e.g. factory/{creator}/ufoo/foo")
+	cmd.Flags().Uint32(FlagExponent, 0, "Exponent of the display unit relative to the base unit")
+	cmd.Flags().String(FlagSymbol, "", "Token symbol, e.g. FOO")
+	cmd.Flags().String(FlagName, "", "Token name, e.g. Foo Token")
+	cmd.Flags().String(FlagDescription, "", "Token description")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// buildDenomMetadata applies the metadata flags that were set on the command
+// to the current metadata of denom. Flags that were not set keep their current
+// value.
+func buildDenomMetadata(cmd *cobra.Command, denom string, current banktypes.Metadata) (banktypes.Metadata, error) {
+	fs := cmd.Flags()
+
+	metadata := banktypes.Metadata{
+		Description: current.Description,
+		Base:        denom,
+		Display:     current.Display,
+		Name:        current.Name,
+		Symbol:      current.Symbol,
+	}
+
+	if fs.Changed(FlagDescription) {
+		metadata.Description, _ = fs.GetString(FlagDescription)
+	}
+	if fs.Changed(FlagName) {
+		metadata.Name, _ = fs.GetString(FlagName)
+	}
+	if fs.Changed(FlagSymbol) {
+		metadata.Symbol, _ = fs.GetString(FlagSymbol)
+	}
+	if fs.Changed(FlagDisplay) {
+		metadata.Display, _ = fs.GetString(FlagDisplay)
+	}
+	if metadata.Display == "" {
+		metadata.Display = denom
+	}
+
+	// the base unit always comes first, keeping any aliases it already had
+	baseUnit := &banktypes.DenomUnit{Denom: denom, Exponent: 0}
+	units := []*banktypes.DenomUnit{}
+	for _, unit := range current.DenomUnits {
+		if unit.Denom == denom {
+			baseUnit.Aliases = unit.Aliases
+			continue
+		}
+		units = append(units, unit)
+	}
+
+	if fs.Changed(FlagDisplay) || fs.Changed(FlagExponent) {
+		exponent, _ := fs.GetUint32(FlagExponent)
+		if !fs.Changed(FlagExponent) {
+			// keep the exponent of an existing unit when only renaming the display unit
+			for _, unit := range units {
+				if unit.Denom == metadata.Display || unit.Denom == current.Display {
+					exponent = unit.Exponent
+					break
+				}
+			}
+		}
+
+		if metadata.Display == denom && exponent != 0 {
+			return banktypes.Metadata{}, fmt.Errorf("--%s is required when --%s is non-zero", FlagDisplay, FlagExponent)
+		}
+		if metadata.Display != denom && exponent == 0 {
+			return banktypes.Metadata{}, fmt.Errorf("--%s must be non-zero for display unit %s", FlagExponent, metadata.Display)
+		}
+
+		// drop the previous display unit and any unit clashing with the new one
+		filtered := []*banktypes.DenomUnit{}
+		for _, unit := range units {
+			if unit.Denom == metadata.Display || unit.Denom == current.Display || unit.Exponent == exponent {
+				continue
+			}
+			filtered = append(filtered, unit)
+		}
+		units = filtered
+
+		if metadata.Display != denom {
+			units = append(units, &banktypes.DenomUnit{Denom: metadata.Display, Exponent: exponent})
+		}
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		return units[i].Exponent < units[j].Exponent
+	})
+	metadata.DenomUnits = append([]*banktypes.DenomUnit{baseUnit}, units...)
+
+	return metadata, nil
+}
+
+// printDenomMetadataDiff writes the fields that differ between the current
+// and the new metadata to w.
+func printDenomMetadataDiff(w io.Writer, current, metadata banktypes.Metadata) {
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"name", current.Name, metadata.Name},
+		{"symbol", current.Symbol, metadata.Symbol},
+		{"description", current.Description, metadata.Description},
+		{"display", current.Display, metadata.Display},
+		{"denom_units", formatDenomUnits(current.DenomUnits), formatDenomUnits(metadata.DenomUnits)},
+	}
+
+	fmt.Fprintf(w, "metadata changes for %s:\n", metadata.Base)
+	changed := false
+	for _, f := range fields {
+		if f.old == f.new {
+			continue
+		}
+		changed = true
+		fmt.Fprintf(w, "- %s: %q\n+ %s: %q\n", f.name, f.old, f.name, f.new)
+	}
+	if !changed {
+		fmt.Fprintln(w, "  (no changes)")
+	}
+}
+
+func formatDenomUnits(units []*banktypes.DenomUnit) string {
+	s := ""
+	for i, unit := range units {
+		if i > 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%s^%d", unit.Denom, unit.Exponent)
+	}
+	return s
+}
+
+func NewLockDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-denom-metadata [denom] [flags]",
+		Short: "Permanently locks the bank metadata of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			partial, err := cmd.Flags().GetBool(FlagPartial)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockDenomMetadata(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				partial,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagPartial, false, "Keep the description of the metadata editable")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetTokenProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-token-profile [denom]",
+		Short: "Sets the extended profile of a factory-created denom. Must have admin authority to do so.",
+		Long: `Sets the extended profile of a factory-created denom, with its logo, website,
+description, tags and contact. The current profile is fetched from the
+tokenfactory module, and only the fields given as flags are changed.
+
+Example:
+$ tx tokenfactory set-token-profile factory/{creator}/ufoo --website=https://foo.org --tags=defi,stablecoin`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			current := types.TokenProfile{}
+			if !clientCtx.Offline {
+				res, err := types.NewQueryClient(clientCtx).TokenProfile(cmd.Context(), &types.QueryTokenProfileRequest{
+					Denom: args[0],
+				})
+				if err == nil {
+					current = res.Profile
+				} else if status.Code(err) != codes.NotFound {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetTokenProfile(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				buildTokenProfile(cmd, current),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLogoURI, "", "URI of the token logo")
+	cmd.Flags().String(FlagLogoURIHash, "", "Hex encoded sha256 hash of the token logo")
+	cmd.Flags().String(FlagWebsite, "", "Website of the token")
+	cmd.Flags().String(FlagDescription, "", "Description of the token")
+	cmd.Flags().StringSlice(FlagTags, nil, "Comma separated tags of the token")
+	cmd.Flags().String(FlagContact, "", "Contact of the project behind the token")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// buildTokenProfile applies the token profile flags that were set on the command to the
+// current profile. Flags that were not set keep their current value.
+func buildTokenProfile(cmd *cobra.Command, current types.TokenProfile) types.TokenProfile {
+	fs := cmd.Flags()
+
+	profile := current
+	if fs.Changed(FlagLogoURI) {
+		profile.LogoURI, _ = fs.GetString(FlagLogoURI)
+	}
+	if fs.Changed(FlagLogoURIHash) {
+		profile.LogoURIHash, _ = fs.GetString(FlagLogoURIHash)
+	}
+	if fs.Changed(FlagWebsite) {
+		profile.Website, _ = fs.GetString(FlagWebsite)
+	}
+	if fs.Changed(FlagDescription) {
+		profile.Description, _ = fs.GetString(FlagDescription)
+	}
+	if fs.Changed(FlagTags) {
+		profile.Tags, _ = fs.GetStringSlice(FlagTags)
+	}
+	if fs.Changed(FlagContact) {
+		profile.Contact, _ = fs.GetString(FlagContact)
+	}
+	return profile
+}
+
+func NewMintVestingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-vesting [coin] [recipient] [flags]",
+		Short: "Mint a denom into escrow, from which it vests to the recipient. Must have admin authority to do so.",
+		Long: `Mint a denom into escrow in the module account, from which it vests to the
+recipient. Nothing vests before the cliff time, and from then on the amount
+vests linearly between the start and end times. Times are Unix timestamps.
+
+Example:
+$ tx tokenfactory mint-vesting 1000000factory/{creator}/ufoo {recipient} --start-time=1700000000 --cliff-time=1731536000 --end-time=1794608000`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			cliffTime, err := cmd.Flags().GetInt64(FlagCliffTime)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(FlagCliffTime) {
+				cliffTime = startTime
+			}
+			endTime, err := cmd.Flags().GetInt64(FlagEndTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintVesting(
+				clientCtx.GetFromAddress().String(),
+				args[1],
+				coin,
+				time.Unix(startTime, 0),
+				time.Unix(cliffTime, 0),
+				time.Unix(endTime, 0),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, time.Now().Unix(), "The Unix timestamp at which vesting starts. Default is now.")
+	cmd.Flags().Int64(FlagCliffTime, 0, "The Unix timestamp before which nothing vests. Default is the start time.")
+	cmd.Flags().Int64(FlagEndTime, 0, "The Unix timestamp at which the full amount is vested")
+	_ = cmd.MarkFlagRequired(FlagEndTime)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimVestedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-vested",
+		Short: "Claim the vested amounts of all the vesting schedules of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgClaimVested(
+				clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateMintScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-mint-schedule [coin] [recipient] [flags]",
+		Short: "Mint a denom to the recipient once every period. Must have admin authority to do so.",
+		Long: `Mint a denom to the recipient once every period, starting one period from now.
+The period is either a number of blocks or a number of seconds. The schedule
+ends after max-mints mints, or runs until it is cancelled if max-mints is 0.
+The amount decreases by decay-rate after every mint, and the schedule ends
+when it reaches zero.
+
+Example:
+$ tx tokenfactory create-mint-schedule 1000000factory/{creator}/ufoo {recipient} --period-blocks=100 --max-mints=12 --decay-rate=0.1`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			periodBlocks, err := cmd.Flags().GetUint64(FlagPeriodBlocks)
+			if err != nil {
+				return err
+			}
+			periodSeconds, err := cmd.Flags().GetUint64(FlagPeriodSeconds)
+			if err != nil {
+				return err
+			}
+			maxMints, err := cmd.Flags().GetUint64(FlagMaxMints)
+			if err != nil {
+				return err
+			}
+			decayRateStr, err := cmd.Flags().GetString(FlagDecayRate)
+			if err != nil {
+				return err
+			}
+			decayRate, err := sdk.NewDecFromStr(decayRateStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMintSchedule(
+				clientCtx.GetFromAddress().String(),
+				args[1],
+				coin,
+				periodBlocks,
+				periodSeconds,
+				maxMints,
+				decayRate,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPeriodBlocks, 0, "The number of blocks between mints")
+	cmd.Flags().Uint64(FlagPeriodSeconds, 0, "The number of seconds between mints")
+	cmd.Flags().Uint64(FlagMaxMints, 0, "The number of mints before the schedule ends. Default is until cancelled.")
+	cmd.Flags().String(FlagDecayRate, "0", "The fraction by which the amount decreases after every mint")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCancelMintScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-mint-schedule [schedule-id] [flags]",
+		Short: "Cancel a mint schedule. Must be the admin of the denom or the creator of the schedule to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelMintSchedule(
+				clientCtx.GetFromAddress().String(),
+				scheduleID,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTakeSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "take-snapshot [denom] [flags]",
+		Short: "Take a snapshot of the balances of the holders of a denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgTakeSnapshot(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewDepositDistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-distribution [denom] [amount] [flags]",
+		Short: "Distribute reward coins pro-rata to the holders of a denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositDistribution(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimDistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-distribution [denom] [flags]",
+		Short: "Claim the rewards distributed to you for holding a denom.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgClaimDistribution(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetHolderIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-holder-index [denom] [enabled] [flags]",
+		Short: "Enable or disable the index of the holders of a denom sorted by balance. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetHolderIndex(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				enabled,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRegisterConversionRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-conversion-route [source-denom] [target-denom] [ratio] [flags]",
+		Short: "Let the holders of a denom convert it into another denom at a ratio of target tokens per source token. Must have admin authority over both denoms to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			ratio, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			var deadline *time.Time
+			if cmd.Flags().Changed(FlagDeadline) {
+				unixDeadline, err := cmd.Flags().GetInt64(FlagDeadline)
+				if err != nil {
+					return err
+				}
+				t := time.Unix(unixDeadline, 0)
+				deadline = &t
+			}
+
+			msg := types.NewMsgRegisterConversionRoute(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				ratio,
+				deadline,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagDeadline, 0, "The Unix timestamp from which the route can't be used anymore. Default is no deadline.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert [amount] [flags]",
+		Short: "Convert an amount of a denom into the target denom of its conversion route.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvert(
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetDenomBackingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-backing [denom] [collateral-denom] [flags]",
+		Short: "Back a denom without supply by a collateral denom, so that it is only minted by wrapping the collateral. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetDenomBacking(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWrapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrap [amount] [flags]",
+		Short: "Mint an amount of a backed denom by locking the same amount of its collateral in escrow.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrap(
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewUnwrapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwrap [amount] [flags]",
+		Short: "Burn an amount of a backed denom and get back the same amount of its collateral.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnwrap(
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetTransferFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-fee [denom] [basis-points] [recipient] [flags]",
+		Short: "Charge a fee in basis points on every transfer of a denom, paid to the recipient. A fee of 0 removes the transfer fee. Must have admin authority to do so.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			basisPoints, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fee := types.TransferFee{BasisPoints: basisPoints}
+			if len(args) == 3 {
+				fee.Recipient = args[2]
+			}
+			fee.ExemptAddresses, err = cmd.Flags().GetStringSlice(FlagExemptAddresses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTransferFee(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				fee,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagExemptAddresses, []string{}, "Addresses that send and receive the denom without a fee, separated by ,")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetRestrictedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-restricted [denom] [restricted] [flags]",
+		Short: "Restrict the transfers and mints of a denom to the addresses on its allowlist, or lift the restriction. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			restricted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRestricted(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				restricted,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddToAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-allowlist [denom] [address,...] [flags]",
+		Short: fmt.Sprintf("Add addresses to the allowlist of a denom, at most %d per transaction. Must have admin authority to do so.", types.MaxAllowlistAddressesPerMsg),
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgAddToAllowlist(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				strings.Split(args[1], ","),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemoveFromAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-from-allowlist [denom] [address,...] [flags]",
+		Short: fmt.Sprintf("Remove addresses from the allowlist of a denom, at most %d per transaction. Must have admin authority to do so.", types.MaxAllowlistAddressesPerMsg),
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRemoveFromAllowlist(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				strings.Split(args[1], ","),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetMaxBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-balance [denom] [amount] [flags]",
+		Short: "Set the highest balance of a denom that an address can hold. An amount of 0 removes the max balance. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			maxBalance := types.MaxBalance{Amount: amount}
+			maxBalance.ExemptAddresses, err = cmd.Flags().GetStringSlice(FlagExemptAddresses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMaxBalance(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxBalance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagExemptAddresses, []string{}, "Addresses without a max balance, separated by ,")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [spender] [amount] [flags]",
+		Short: "Let a spender transfer up to an amount of a denom from your balance, replacing its previous allowance for the denom.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if cmd.Flags().Changed(FlagExpiration) {
+				unixExpiration, err := cmd.Flags().GetInt64(FlagExpiration)
+				if err != nil {
+					return err
+				}
+				t := time.Unix(unixExpiration, 0)
+				expiration = &t
+			}
+
+			msg := types.NewMsgApprove(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amount,
+				expiration,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpiration, 0, "The Unix timestamp from which the allowance can't be used anymore. Default is no expiration.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTransferFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-from [owner] [recipient] [amount] [flags]",
+		Short: "Transfer an amount of a denom from the balance of an owner within your allowance.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferFrom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevokeApprovalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-approval [spender] [denom] [flags]",
+		Short: "Remove the allowance of a spender for a denom.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRevokeApproval(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewGrantMintCmd() *cobra.Command {
+	cmd := newGrantCmd(
+		"grant-mint [grantee] [spend-limit]",
+		"Grant an address authorization to mint up to spend-limit of your denoms.",
+		func(cmd *cobra.Command, spendLimit sdk.Coins) (authz.Authorization, error) {
+			allowedRecipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewMintAuthorization(spendLimit, allowedRecipients), nil
+		},
+	)
+
+	cmd.Flags().StringSlice(FlagAllowedRecipients, []string{}, "Addresses the grantee may mint to, separated by ,. Defaults to any address.")
+
+	return cmd
+}
+
+func NewGrantBurnCmd() *cobra.Command {
+	cmd := newGrantCmd(
+		"grant-burn [grantee] [spend-limit]",
+		"Grant an address authorization to burn up to spend-limit of your denoms.",
+		func(cmd *cobra.Command, spendLimit sdk.Coins) (authz.Authorization, error) {
+			allowedFromAddresses, err := cmd.Flags().GetStringSlice(FlagAllowedFromAddresses)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewBurnAuthorization(spendLimit, allowedFromAddresses), nil
+		},
+	)
+
+	cmd.Flags().StringSlice(FlagAllowedFromAddresses, []string{}, "Addresses the grantee may burn from, separated by ,. Defaults to any address.")
+
+	return cmd
+}
+
+func NewGrantForceTransferCmd() *cobra.Command {
+	cmd := newGrantCmd(
+		"grant-force-transfer [grantee] [spend-limit]",
+		"Grant an address authorization to force transfer up to spend-limit of your denoms.",
+		func(cmd *cobra.Command, spendLimit sdk.Coins) (authz.Authorization, error) {
+			allowedFromAddresses, err := cmd.Flags().GetStringSlice(FlagAllowedFromAddresses)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewForceTransferAuthorization(spendLimit, allowedFromAddresses), nil
+		},
+	)
+
+	cmd.Flags().StringSlice(FlagAllowedFromAddresses, []string{}, "Addresses the grantee may transfer from, separated by ,. Defaults to any address.")
+
+	return cmd
+}
+
+// newGrantCmd returns a command that broadcasts an authz MsgGrant of the
+// authorization built by newAuthorization.
+func newGrantCmd(use, short string, newAuthorization func(*cobra.Command, sdk.Coins) (authz.Authorization, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			authorization, err := newAuthorization(cmd, spendLimit)
+			if err != nil {
+				return err
+			}
+
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
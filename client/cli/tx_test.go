@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+const testDenom = "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/ufoo"
+
+// TestBuildDenomMetadata tests that metadata flags are merged into the current metadata
+func TestBuildDenomMetadata(t *testing.T) {
+	defaultMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: testDenom, Exponent: 0}},
+		Base:       testDenom,
+	}
+
+	for _, tc := range []struct {
+		desc      string
+		current   banktypes.Metadata
+		args      []string
+		expected  banktypes.Metadata
+		expectErr bool
+	}{
+		{
+			desc:    "add display unit to metadata set on denom creation",
+			current: defaultMetadata,
+			args:    []string{"--display=foo", "--exponent=6", "--symbol=FOO", "--name=Foo Token"},
+			expected: banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: testDenom, Exponent: 0},
+					{Denom: "foo", Exponent: 6},
+				},
+				Base:    testDenom,
+				Display: "foo",
+				Name:    "Foo Token",
+				Symbol:  "FOO",
+			},
+		},
+		{
+			desc: "change exponent keeps other fields and base aliases",
+			current: banktypes.Metadata{
+				Description: "foo",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: testDenom, Exponent: 0, Aliases: []string{"microfoo"}},
+					{Denom: "mfoo", Exponent: 3},
+					{Denom: "foo", Exponent: 6},
+				},
+				Base:    testDenom,
+				Display: "foo",
+				Name:    "Foo Token",
+				Symbol:  "FOO",
+			},
+			args: []string{"--exponent=9"},
+			expected: banktypes.Metadata{
+				Description: "foo",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: testDenom, Exponent: 0, Aliases: []string{"microfoo"}},
+					{Denom: "mfoo", Exponent: 3},
+					{Denom: "foo", Exponent: 9},
+				},
+				Base:    testDenom,
+				Display: "foo",
+				Name:    "Foo Token",
+				Symbol:  "FOO",
+			},
+		},
+		{
+			desc: "rename display unit keeps its exponent",
+			current: banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: testDenom, Exponent: 0},
+					{Denom: "foo", Exponent: 6},
+				},
+				Base:    testDenom,
+				Display: "foo",
+			},
+			args: []string{"--display=bar"},
+			expected: banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: testDenom, Exponent: 0},
+					{Denom: "bar", Exponent: 6},
+				},
+				Base:    testDenom,
+				Display: "bar",
+			},
+		},
+		{
+			desc:      "exponent without display unit",
+			current:   defaultMetadata,
+			args:      []string{"--exponent=6"},
+			expectErr: true,
+		},
+		{
+			desc:      "display unit without exponent",
+			current:   defaultMetadata,
+			args:      []string{"--display=foo"},
+			expectErr: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cmd := NewSetDenomMetadataCmd()
+			require.NoError(t, cmd.Flags().Parse(tc.args))
+
+			metadata, err := buildDenomMetadata(cmd, testDenom, tc.current)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, metadata)
+		})
+	}
+}
+
+// TestBuildTokenProfile tests that token profile flags are merged into the current profile
+func TestBuildTokenProfile(t *testing.T) {
+	current := types.TokenProfile{
+		Website:     "https://foo.org",
+		Description: "foo",
+		Tags:        []string{"defi"},
+	}
+
+	cmd := NewSetTokenProfileCmd()
+	require.NoError(t, cmd.Flags().Parse([]string{"--tags=defi,stablecoin", "--contact=team@foo.org", "--description="}))
+
+	require.Equal(t, types.TokenProfile{
+		Website: "https://foo.org",
+		Tags:    []string{"defi", "stablecoin"},
+		Contact: "team@foo.org",
+	}, buildTokenProfile(cmd, current))
+}
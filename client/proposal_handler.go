@@ -0,0 +1,33 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/osmosis-labs/tokenfactory/client/cli"
+)
+
+// proposal handlers of the tokenfactory proposals, to register with the gov module basics
+var (
+	UpdateReservedSubdenomsProposalHandler = govclient.NewProposalHandler(cli.NewSubmitUpdateReservedSubdenomsProposalCmd, newUnsupportedRESTHandler("tokenfactory_update_reserved_subdenoms"))
+	DelistDenomProposalHandler             = govclient.NewProposalHandler(cli.NewSubmitDelistDenomProposalCmd, newUnsupportedRESTHandler("tokenfactory_delist_denom"))
+	SetDenomAdminProposalHandler           = govclient.NewProposalHandler(cli.NewSubmitSetDenomAdminProposalCmd, newUnsupportedRESTHandler("tokenfactory_set_denom_admin"))
+	FreezeDenomProposalHandler             = govclient.NewProposalHandler(cli.NewSubmitFreezeDenomProposalCmd, newUnsupportedRESTHandler("tokenfactory_freeze_denom"))
+)
+
+// newUnsupportedRESTHandler returns a REST handler that rejects all requests, as the module
+// doesn't register any legacy REST routes
+func newUnsupportedRESTHandler(subRoute string) govclient.RESTHandlerFn {
+	return func(client.Context) govrest.ProposalRESTHandler {
+		return govrest.ProposalRESTHandler{
+			SubRoute: subRoute,
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				rest.WriteErrorResponse(w, http.StatusNotImplemented, "legacy REST is not supported, use the CLI or gRPC instead")
+			},
+		}
+	}
+}
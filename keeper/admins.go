@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/osmosis-labs/tokenfactory/types"
@@ -9,7 +12,7 @@ import (
 
 // GetAuthorityMetadata returns the authority metadata for a specific denom
 func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, error) {
-	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomAuthorityMetadataKey))
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomAuthorityMetadataKey)
 
 	metadata := types.DenomAuthorityMetadata{}
 	err := proto.Unmarshal(bz, &metadata)
@@ -33,7 +36,7 @@ func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata typ
 		return err
 	}
 
-	store.Set([]byte(types.DenomAuthorityMetadataKey), bz)
+	store.Set(types.DenomAuthorityMetadataKey, bz)
 	return nil
 }
 
@@ -47,3 +50,84 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+func (k Keeper) setFrozen(ctx sdk.Context, denom string, frozen bool) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.Frozen = frozen
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) setMetadataLock(ctx sdk.Context, denom string, lock types.MetadataLock) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.MetadataLock = lock
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// checkMetadataLock returns an error if the metadata lock of a denom doesn't allow
+// replacing its bank metadata with metadata.
+func (k Keeper) checkMetadataLock(ctx sdk.Context, lock types.MetadataLock, metadata banktypes.Metadata) error {
+	switch lock {
+	case types.MetadataLockFull:
+		return types.ErrDenomMetadataLocked.Wrapf("denom: %s", metadata.Base)
+	case types.MetadataLockPartial:
+		current, _ := k.bankKeeper.GetDenomMetaData(ctx, metadata.Base)
+		current.Description = metadata.Description
+		if !proto.Equal(&current, &metadata) {
+			return types.ErrDenomMetadataLocked.Wrapf("only the description of denom %s can be changed", metadata.Base)
+		}
+	}
+	return nil
+}
+
+// validateDenomMetadata checks the tokenfactory specific rules of the metadata of a
+// denom: the non-base denom units and their aliases must be namespaced under the base
+// denom or match one of the DenomUnitPatterns, and the symbol must not be reserved. The
+// metadata of this SDK version has no URI and URIHash fields, the URI of a denom and its
+// hash are part of its TokenProfile.
+func (k Keeper) validateDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata) error {
+	params := k.GetParams(ctx)
+
+	for _, unit := range metadata.DenomUnits {
+		denoms := unit.Aliases
+		if unit.Denom != metadata.Base {
+			denoms = append([]string{unit.Denom}, denoms...)
+		}
+
+		for _, denom := range denoms {
+			if !isDenomUnitAllowed(params, metadata.Base, denom) {
+				return types.ErrInvalidDenomMetadata.Wrapf("denom unit %s must be namespaced under %s/", denom, metadata.Base)
+			}
+		}
+	}
+
+	for _, symbol := range params.ReservedSymbols {
+		if strings.EqualFold(symbol, metadata.Symbol) {
+			return types.ErrSymbolReserved.Wrapf("symbol: %s", metadata.Symbol)
+		}
+	}
+
+	return nil
+}
+
+func isDenomUnitAllowed(params types.Params, base, denom string) bool {
+	if strings.HasPrefix(denom, base+"/") {
+		return true
+	}
+
+	for _, pattern := range params.DenomUnitPatterns {
+		if types.MatchReservedSubdenomPattern(pattern, denom) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -444,12 +446,12 @@ func (s *KeeperTestSuite) TestSetDenomMetaData() {
 						Exponent: 0,
 					},
 					{
-						Denom:    "uosmo",
+						Denom:    s.defaultDenom + "/osmo",
 						Exponent: 6,
 					},
 				},
 				Base:    s.defaultDenom,
-				Display: "uosmo",
+				Display: s.defaultDenom + "/osmo",
 				Name:    "OSMO",
 				Symbol:  "OSMO",
 			}),
@@ -535,6 +537,45 @@ func (s *KeeperTestSuite) TestSetDenomMetaData() {
 			}),
 			expectedPass: false,
 		},
+		{
+			desc: "denom unit not namespaced under the base denom",
+			msgSetDenomMetadata: *types.NewMsgSetDenomMetadata(s.TestAccs[0].String(), banktypes.Metadata{
+				Description: "yeehaw",
+				DenomUnits: []*banktypes.DenomUnit{
+					{
+						Denom:    s.defaultDenom,
+						Exponent: 0,
+					},
+					{
+						Denom:    "uosmo",
+						Exponent: 6,
+					},
+				},
+				Base:    s.defaultDenom,
+				Display: "uosmo",
+				Name:    "OSMO",
+				Symbol:  "OSMO",
+			}),
+			expectedPass: false,
+		},
+		{
+			desc: "alias not namespaced under the base denom",
+			msgSetDenomMetadata: *types.NewMsgSetDenomMetadata(s.TestAccs[0].String(), banktypes.Metadata{
+				Description: "yeehaw",
+				DenomUnits: []*banktypes.DenomUnit{
+					{
+						Denom:    s.defaultDenom,
+						Exponent: 0,
+						Aliases:  []string{"uatom"},
+					},
+				},
+				Base:    s.defaultDenom,
+				Display: s.defaultDenom,
+				Name:    "OSMO",
+				Symbol:  "OSMO",
+			}),
+			expectedPass: false,
+		},
 	} {
 		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
 			bankKeeper := s.App.BankKeeper
@@ -552,3 +593,246 @@ func (s *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGovSetDenomAdmin() {
+	authority := s.App.TokenfactoryKeeper.GetAuthority()
+
+	for _, tc := range []struct {
+		desc      string
+		sender    func() string
+		denom     func() string
+		newAdmin  func() string
+		expectErr error
+	}{
+		{
+			desc:      "non-authority can't reassign admin",
+			sender:    func() string { return s.TestAccs[0].String() },
+			denom:     func() string { return s.defaultDenom },
+			newAdmin:  func() string { return s.TestAccs[1].String() },
+			expectErr: types.ErrUnauthorized,
+		},
+		{
+			desc:      "denom does not exist",
+			sender:    func() string { return authority },
+			denom:     func() string { return fmt.Sprintf("factory/%s/evmos", s.TestAccs[0]) },
+			newAdmin:  func() string { return s.TestAccs[1].String() },
+			expectErr: types.ErrDenomDoesNotExist,
+		},
+		{
+			desc:     "authority reassigns admin",
+			sender:   func() string { return authority },
+			denom:    func() string { return s.defaultDenom },
+			newAdmin: func() string { return s.TestAccs[1].String() },
+		},
+		{
+			desc:     "authority removes admin",
+			sender:   func() string { return authority },
+			denom:    func() string { return s.defaultDenom },
+			newAdmin: func() string { return "" },
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := s.msgServer.GovSetDenomAdmin(sdk.WrapSDKContext(ctx), types.NewMsgGovSetDenomAdmin(tc.sender(), tc.denom(), tc.newAdmin()))
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				s.AssertEventEmitted(ctx, proto.MessageName(&types.EventGovSetDenomAdmin{}), 0)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventGovSetDenomAdmin{}), 1)
+
+			authorityMetadata, err := s.App.TokenfactoryKeeper.GetAuthorityMetadata(s.Ctx, s.defaultDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.newAdmin(), authorityMetadata.Admin)
+
+			// the previous admin lost its authority
+			_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGovFreezeDenom() {
+	authority := s.App.TokenfactoryKeeper.GetAuthority()
+	s.CreateDefaultDenom()
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+
+	// only the authority can freeze a denom
+	_, err = s.msgServer.GovFreezeDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgGovFreezeDenom(s.TestAccs[0].String(), s.defaultDenom, true))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.GovFreezeDenom(sdk.WrapSDKContext(ctx), types.NewMsgGovFreezeDenom(authority, s.defaultDenom, true))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventGovFreezeDenom{}), 1)
+
+	queryRes, err := s.queryClient.DenomAuthorityMetadata(s.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
+		Denom: s.defaultDenom,
+	})
+	s.Require().NoError(err)
+	s.Require().True(queryRes.AuthorityMetadata.Frozen)
+	s.Require().Equal(s.TestAccs[0].String(), queryRes.AuthorityMetadata.Admin)
+
+	// all admin actions are disabled while the denom is frozen
+	sender := s.TestAccs[0].String()
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(sender, sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurn(sender, sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(sender, sdk.NewInt64Coin(s.defaultDenom, 10), sender, s.TestAccs[1].String()))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+	_, err = s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(sender, s.defaultDenom, s.TestAccs[1].String()))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+	_, err = s.msgServer.DeleteDenom(goCtx, types.NewMsgDeleteDenom(sender, s.defaultDenom, false))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+
+	// governance can still reassign the admin of a frozen denom, which stays frozen
+	_, err = s.msgServer.GovSetDenomAdmin(goCtx, types.NewMsgGovSetDenomAdmin(authority, s.defaultDenom, s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.TestAccs[1].String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+
+	// unfreezing restores admin actions
+	_, err = s.msgServer.GovFreezeDenom(goCtx, types.NewMsgGovFreezeDenom(authority, s.defaultDenom, false))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(s.TestAccs[1].String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestLockDenomMetadata() {
+	for _, tc := range []struct {
+		desc      string
+		partial   bool
+		expectErr bool
+	}{
+		{
+			desc:      "full lock",
+			partial:   false,
+			expectErr: true,
+		},
+		{
+			desc:      "partial lock",
+			partial:   true,
+			expectErr: false,
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			goCtx := sdk.WrapSDKContext(s.Ctx)
+			metadata := banktypes.Metadata{
+				Description: "yeehaw",
+				DenomUnits: []*banktypes.DenomUnit{
+					{
+						Denom:    s.defaultDenom,
+						Exponent: 0,
+					},
+					{
+						Denom:    s.defaultDenom + "/btc",
+						Exponent: 8,
+					},
+				},
+				Base:    s.defaultDenom,
+				Display: s.defaultDenom + "/btc",
+				Name:    "Bitcoin",
+				Symbol:  "BTC",
+			}
+			_, err := s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.TestAccs[0].String(), metadata))
+			s.Require().NoError(err)
+
+			// only the admin can lock the metadata
+			_, err = s.msgServer.LockDenomMetadata(goCtx, types.NewMsgLockDenomMetadata(s.TestAccs[1].String(), s.defaultDenom, tc.partial))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = s.msgServer.LockDenomMetadata(sdk.WrapSDKContext(ctx), types.NewMsgLockDenomMetadata(s.TestAccs[0].String(), s.defaultDenom, tc.partial))
+			s.Require().NoError(err)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventLockDenomMetadata{}), 1)
+
+			// the lock state is returned by the authority metadata query
+			expectedLock := types.MetadataLockFull
+			if tc.partial {
+				expectedLock = types.MetadataLockPartial
+			}
+			queryRes, err := s.queryClient.DenomAuthorityMetadata(s.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
+				Denom: s.defaultDenom,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(expectedLock, queryRes.AuthorityMetadata.MetadataLock)
+
+			// the exponent can't be changed
+			changedExponent := metadata
+			changedExponent.DenomUnits = []*banktypes.DenomUnit{metadata.DenomUnits[0], {Denom: s.defaultDenom + "/btc", Exponent: 6}}
+			_, err = s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.TestAccs[0].String(), changedExponent))
+			s.Require().ErrorIs(err, types.ErrDenomMetadataLocked)
+
+			// the description can only be changed with a partial lock
+			changedDescription := metadata
+			changedDescription.Description = "the original"
+			_, err = s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.TestAccs[0].String(), changedDescription))
+			if tc.expectErr {
+				s.Require().ErrorIs(err, types.ErrDenomMetadataLocked)
+			} else {
+				s.Require().NoError(err)
+				bankMetadata, _ := s.App.BankKeeper.GetDenomMetaData(s.Ctx, s.defaultDenom)
+				s.Require().Equal("the original", bankMetadata.Description)
+			}
+
+			// the lock can't be loosened or applied twice
+			_, err = s.msgServer.LockDenomMetadata(goCtx, types.NewMsgLockDenomMetadata(s.TestAccs[0].String(), s.defaultDenom, true))
+			s.Require().ErrorIs(err, types.ErrDenomMetadataLocked)
+
+			// a partial lock can be made full, and survives a change of admin
+			_, err = s.msgServer.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(s.TestAccs[0].String(), s.defaultDenom, s.TestAccs[1].String()))
+			s.Require().NoError(err)
+			_, err = s.msgServer.LockDenomMetadata(goCtx, types.NewMsgLockDenomMetadata(s.TestAccs[1].String(), s.defaultDenom, false))
+			if tc.partial {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrDenomMetadataLocked)
+			}
+			_, err = s.msgServer.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(s.TestAccs[1].String(), metadata))
+			s.Require().ErrorIs(err, types.ErrDenomMetadataLocked)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadataParams() {
+	s.CreateDefaultDenom()
+	params := s.App.TokenfactoryKeeper.GetParams(s.Ctx)
+	params.DenomUnitPatterns = []string{"tf*"}
+	params.ReservedSymbols = []string{"OSMO"}
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    s.defaultDenom,
+				Exponent: 0,
+			},
+			{
+				Denom:    "tfbitcoin",
+				Exponent: 8,
+			},
+		},
+		Base:    s.defaultDenom,
+		Display: "tfbitcoin",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+
+	// denom units matching a pattern are allowed
+	_, err := s.msgServer.SetDenomMetadata(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomMetadata(s.TestAccs[0].String(), metadata))
+	s.Require().NoError(err)
+
+	// reserved symbols are compared case-insensitively
+	metadata.Symbol = "osmo"
+	_, err = s.msgServer.SetDenomMetadata(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomMetadata(s.TestAccs[0].String(), metadata))
+	s.Require().ErrorIs(err, types.ErrSymbolReserved)
+}
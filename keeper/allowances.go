@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetAllowance returns the allowance of a spender for the balance of an owner, including
+// an expired one
+func (k Keeper) GetAllowance(ctx sdk.Context, denom string, owner, spender sdk.AccAddress) (types.Allowance, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetAllowanceKey(owner, spender))
+	if bz == nil {
+		return types.Allowance{}, false
+	}
+
+	allowance := types.Allowance{}
+	if err := proto.Unmarshal(bz, &allowance); err != nil {
+		panic(err)
+	}
+	return allowance, true
+}
+
+// GetAllowances returns the allowances of all the owners of a denom, including the expired
+// ones
+func (k Keeper) GetAllowances(ctx sdk.Context, denom string) []types.Allowance {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomAllowancePrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allowances := []types.Allowance{}
+	for ; iterator.Valid(); iterator.Next() {
+		allowance := types.Allowance{}
+		if err := proto.Unmarshal(iterator.Value(), &allowance); err != nil {
+			panic(err)
+		}
+		allowances = append(allowances, allowance)
+	}
+	return allowances
+}
+
+func (k Keeper) setAllowance(ctx sdk.Context, allowance types.Allowance) error {
+	err := allowance.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&allowance)
+	if err != nil {
+		return err
+	}
+
+	owner := sdk.MustAccAddressFromBech32(allowance.Owner)
+	spender := sdk.MustAccAddressFromBech32(allowance.Spender)
+	k.GetDenomPrefixStore(ctx, allowance.Amount.Denom).Set(types.GetAllowanceKey(owner, spender), bz)
+	return nil
+}
+
+func (k Keeper) deleteAllowance(ctx sdk.Context, denom string, owner, spender sdk.AccAddress) {
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.GetAllowanceKey(owner, spender))
+}
+
+// deleteAllowances removes the allowances of all the owners of a denom
+func (k Keeper) deleteAllowances(ctx sdk.Context, denom string) {
+	for _, allowance := range k.GetAllowances(ctx, denom) {
+		owner := sdk.MustAccAddressFromBech32(allowance.Owner)
+		spender := sdk.MustAccAddressFromBech32(allowance.Spender)
+		k.deleteAllowance(ctx, denom, owner, spender)
+	}
+}
+
+// approve sets the allowance of a spender, replacing its previous allowance for the denom
+func (k Keeper) approve(ctx sdk.Context, allowance types.Allowance) error {
+	if allowance.IsExpired(ctx.BlockTime()) {
+		return types.ErrInvalidAllowance.Wrapf("expiration %s has passed", allowance.Expiration)
+	}
+	return k.setAllowance(ctx, allowance)
+}
+
+// transferFrom sends amount from owner to recipient, and subtracts it from the allowance of
+// spender. Allowances that are used up are removed. As with the sends of the bank module,
+// the recipient can't be an address that the bank module doesn't allow to receive funds,
+// nor a module account.
+func (k Keeper) transferFrom(ctx sdk.Context, spender, owner, recipient sdk.AccAddress, amount sdk.Coin) error {
+	if k.isModuleOrBlockedAccount(ctx, recipient) {
+		return types.ErrTransferToModuleAccount.Wrapf("recipient: %s", recipient)
+	}
+
+	allowance, found := k.GetAllowance(ctx, amount.Denom, owner, spender)
+	if !found || allowance.IsExpired(ctx.BlockTime()) {
+		return types.ErrAllowanceNotFound.Wrapf("denom: %s, owner: %s, spender: %s", amount.Denom, owner, spender)
+	}
+	if allowance.Amount.IsLT(amount) {
+		return types.ErrInsufficientAllowance.Wrapf("allowance: %s, amount: %s", allowance.Amount, amount)
+	}
+
+	allowance.Amount = allowance.Amount.Sub(amount)
+	if allowance.Amount.IsZero() {
+		k.deleteAllowance(ctx, amount.Denom, owner, spender)
+	} else {
+		err := k.setAllowance(ctx, allowance)
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.bankKeeper.SendCoins(ctx, owner, recipient, sdk.NewCoins(amount))
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestApprove() {
+	s.CreateDefaultDenom()
+	owner, spender := s.TestAccs[1], s.TestAccs[2]
+	amount := sdk.NewInt64Coin(s.defaultDenom, 100)
+
+	// the denom must exist
+	_, err := s.msgServer.Approve(sdk.WrapSDKContext(s.Ctx), types.NewMsgApprove(owner.String(), spender.String(), sdk.NewInt64Coin("factory/"+owner.String()+"/none", 100), nil))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	// the expiration can't have passed
+	expiration := s.Ctx.BlockTime().Add(-time.Second)
+	_, err = s.msgServer.Approve(sdk.WrapSDKContext(s.Ctx), types.NewMsgApprove(owner.String(), spender.String(), amount, &expiration))
+	s.Require().ErrorIs(err, types.ErrInvalidAllowance)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.Approve(sdk.WrapSDKContext(ctx), types.NewMsgApprove(owner.String(), spender.String(), amount, nil))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventApprove{}), 1)
+
+	res, err := s.queryClient.Allowance(s.Ctx.Context(), &types.QueryAllowanceRequest{
+		Denom:   s.defaultDenom,
+		Owner:   owner.String(),
+		Spender: spender.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(amount, res.Allowance.Amount)
+	s.Require().Nil(res.Allowance.Expiration)
+
+	// a new approval replaces the previous allowance
+	expiration = s.Ctx.BlockTime().Add(time.Hour)
+	_, err = s.msgServer.Approve(sdk.WrapSDKContext(s.Ctx), types.NewMsgApprove(owner.String(), spender.String(), sdk.NewInt64Coin(s.defaultDenom, 30), &expiration))
+	s.Require().NoError(err)
+	allowance, found := s.App.TokenfactoryKeeper.GetAllowance(s.Ctx, s.defaultDenom, owner, spender)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt64Coin(s.defaultDenom, 30), allowance.Amount)
+	s.Require().True(expiration.Equal(*allowance.Expiration))
+
+	// expired allowances aren't returned
+	_, err = s.App.TokenfactoryKeeper.Allowance(sdk.WrapSDKContext(s.Ctx.WithBlockTime(expiration)), &types.QueryAllowanceRequest{
+		Denom:   s.defaultDenom,
+		Owner:   owner.String(),
+		Spender: spender.String(),
+	})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestTransferFrom() {
+	s.CreateDefaultDenom()
+	admin, owner, spender := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	recipient := CreateRandomAccounts(1)[0]
+
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1000), owner.String()))
+	s.Require().NoError(err)
+
+	// transfers need an allowance
+	_, err = s.msgServer.TransferFrom(sdk.WrapSDKContext(s.Ctx), types.NewMsgTransferFrom(spender.String(), owner.String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrAllowanceNotFound)
+
+	expiration := s.Ctx.BlockTime().Add(time.Hour)
+	_, err = s.msgServer.Approve(sdk.WrapSDKContext(s.Ctx), types.NewMsgApprove(owner.String(), spender.String(), sdk.NewInt64Coin(s.defaultDenom, 100), &expiration))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.TransferFrom(sdk.WrapSDKContext(ctx), types.NewMsgTransferFrom(spender.String(), owner.String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 60)))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventTransferFrom{}), 1)
+	s.Require().Equal(sdk.NewInt(940), s.App.BankKeeper.GetBalance(s.Ctx, owner, s.defaultDenom).Amount)
+	s.Require().Equal(sdk.NewInt(60), s.App.BankKeeper.GetBalance(s.Ctx, recipient, s.defaultDenom).Amount)
+
+	allowance, found := s.App.TokenfactoryKeeper.GetAllowance(s.Ctx, s.defaultDenom, owner, spender)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt64Coin(s.defaultDenom, 40), allowance.Amount)
+
+	// transfers can't exceed the allowance
+	_, err = s.msgServer.TransferFrom(sdk.WrapSDKContext(s.Ctx), types.NewMsgTransferFrom(spender.String(), owner.String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 41)))
+	s.Require().ErrorIs(err, types.ErrInsufficientAllowance)
+
+	// nor use an expired allowance
+	_, err = s.msgServer.TransferFrom(sdk.WrapSDKContext(s.Ctx.WithBlockTime(expiration)), types.NewMsgTransferFrom(spender.String(), owner.String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrAllowanceNotFound)
+
+	// a used up allowance is removed
+	_, err = s.msgServer.TransferFrom(sdk.WrapSDKContext(s.Ctx), types.NewMsgTransferFrom(spender.String(), owner.String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 40)))
+	s.Require().NoError(err)
+	_, found = s.App.TokenfactoryKeeper.GetAllowance(s.Ctx, s.defaultDenom, owner, spender)
+	s.Require().False(found)
+	s.Require().Equal(sdk.NewInt(100), s.App.BankKeeper.GetBalance(s.Ctx, recipient, s.defaultDenom).Amount)
+}
+
+func (s *KeeperTestSuite) TestTransferFromToModuleAccount() {
+	s.CreateDefaultDenom()
+	admin, owner, spender := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1000), owner.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Approve(sdk.WrapSDKContext(s.Ctx), types.NewMsgApprove(owner.String(), spender.String(), sdk.NewInt64Coin(s.defaultDenom, 100), nil))
+	s.Require().NoError(err)
+
+	// transfers to module accounts and blocked addresses are rejected, like bank sends
+	for _, moduleName := range []string{types.ModuleName, distrtypes.ModuleName} {
+		recipient := s.App.AccountKeeper.GetModuleAddress(moduleName)
+		_, err = s.msgServer.TransferFrom(sdk.WrapSDKContext(s.Ctx), types.NewMsgTransferFrom(spender.String(), owner.String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+		s.Require().ErrorIs(err, types.ErrTransferToModuleAccount)
+		s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, recipient, s.defaultDenom).IsZero())
+	}
+
+	allowance, found := s.App.TokenfactoryKeeper.GetAllowance(s.Ctx, s.defaultDenom, owner, spender)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt64Coin(s.defaultDenom, 100), allowance.Amount)
+}
+
+func (s *KeeperTestSuite) TestRevokeApproval() {
+	s.CreateDefaultDenom()
+	owner, spender := s.TestAccs[1], s.TestAccs[2]
+
+	_, err := s.msgServer.RevokeApproval(sdk.WrapSDKContext(s.Ctx), types.NewMsgRevokeApproval(owner.String(), spender.String(), s.defaultDenom))
+	s.Require().ErrorIs(err, types.ErrAllowanceNotFound)
+
+	_, err = s.msgServer.Approve(sdk.WrapSDKContext(s.Ctx), types.NewMsgApprove(owner.String(), spender.String(), sdk.NewInt64Coin(s.defaultDenom, 100), nil))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.RevokeApproval(sdk.WrapSDKContext(ctx), types.NewMsgRevokeApproval(owner.String(), spender.String(), s.defaultDenom))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventRevokeApproval{}), 1)
+
+	_, found := s.App.TokenfactoryKeeper.GetAllowance(s.Ctx, s.defaultDenom, owner, spender)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAllowancesQuery() {
+	s.CreateDefaultDenom()
+	owner := s.TestAccs[1]
+	spenders := CreateRandomAccounts(3)
+
+	expiration := s.Ctx.BlockTime().Add(time.Hour)
+	for i, spender := range spenders {
+		var spenderExpiration *time.Time
+		if i == 0 {
+			spenderExpiration = &expiration
+		}
+		_, err := s.msgServer.Approve(sdk.WrapSDKContext(s.Ctx), types.NewMsgApprove(owner.String(), spender.String(), sdk.NewInt64Coin(s.defaultDenom, 100), spenderExpiration))
+		s.Require().NoError(err)
+	}
+
+	res, err := s.queryClient.Allowances(s.Ctx.Context(), &types.QueryAllowancesRequest{
+		Denom:      s.defaultDenom,
+		Owner:      owner.String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Allowances, 2)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+
+	// expired allowances are skipped
+	res, err = s.App.TokenfactoryKeeper.Allowances(sdk.WrapSDKContext(s.Ctx.WithBlockTime(expiration)), &types.QueryAllowancesRequest{
+		Denom: s.defaultDenom,
+		Owner: owner.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Allowances, 2)
+	for _, allowance := range res.Allowances {
+		s.Require().NotEqual(spenders[0].String(), allowance.Spender)
+	}
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// IsRestricted returns whether the transfers of a denom are restricted to the addresses on
+// its allowlist
+func (k Keeper) IsRestricted(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.DenomRestrictedKey)
+}
+
+func (k Keeper) setRestricted(ctx sdk.Context, denom string, restricted bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if restricted {
+		store.Set(types.DenomRestrictedKey, []byte{})
+	} else {
+		store.Delete(types.DenomRestrictedKey)
+	}
+}
+
+// IsAllowlisted returns whether addr is on the allowlist of a denom
+func (k Keeper) IsAllowlisted(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetAllowlistKey(addr))
+}
+
+// GetAllowlist returns the addresses on the allowlist of a denom
+func (k Keeper) GetAllowlist(ctx sdk.Context, denom string) []string {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomAllowlistPrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	addresses := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Key()).String())
+	}
+	return addresses
+}
+
+func (k Keeper) addToAllowlist(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetAllowlistKey(addr), []byte{})
+}
+
+func (k Keeper) removeFromAllowlist(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.GetAllowlistKey(addr))
+}
+
+// deleteAllowlist removes the restricted flag and the allowlist of a denom
+func (k Keeper) deleteAllowlist(ctx sdk.Context, denom string) {
+	for _, addr := range k.GetAllowlist(ctx, denom) {
+		k.removeFromAllowlist(ctx, denom, sdk.MustAccAddressFromBech32(addr))
+	}
+	k.setRestricted(ctx, denom, false)
+}
+
+// checkAllowlisted returns an error if a denom is restricted and addr isn't on its
+// allowlist
+func (k Keeper) checkAllowlisted(ctx sdk.Context, denom string, addr sdk.AccAddress) error {
+	if k.IsRestricted(ctx, denom) && !k.IsAllowlisted(ctx, denom, addr) {
+		return types.ErrNotAllowlisted.Wrapf("denom: %s, address: %s", denom, addr)
+	}
+	return nil
+}
+
+// checkRestrictedSend returns an error if one of the factory denoms in amount is restricted
+// and from or to isn't on its allowlist
+func (k Keeper) checkRestrictedSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if err := k.checkAllowlisted(ctx, coin.Denom, from); err != nil {
+			return err
+		}
+		if err := k.checkAllowlisted(ctx, coin.Denom, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestAllowlist() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0]
+	addresses := []string{s.TestAccs[1].String(), s.TestAccs[2].String()}
+
+	// only the admin can update the allowlist
+	_, err := s.msgServer.AddToAllowlist(sdk.WrapSDKContext(s.Ctx), types.NewMsgAddToAllowlist(s.TestAccs[1].String(), s.defaultDenom, addresses))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetRestricted(sdk.WrapSDKContext(ctx), types.NewMsgSetRestricted(admin.String(), s.defaultDenom, true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddToAllowlist(sdk.WrapSDKContext(ctx), types.NewMsgAddToAllowlist(admin.String(), s.defaultDenom, addresses))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetRestricted{}), 1)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventAddToAllowlist{}), 1)
+
+	res, err := s.queryClient.Allowlist(s.Ctx.Context(), &types.QueryAllowlistRequest{
+		Denom:      s.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.Restricted)
+	s.Require().Len(res.Addresses, 1)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	_, err = s.msgServer.RemoveFromAllowlist(sdk.WrapSDKContext(s.Ctx), types.NewMsgRemoveFromAllowlist(admin.String(), s.defaultDenom, addresses[:1]))
+	s.Require().NoError(err)
+	s.Require().Equal(addresses[1:], s.App.TokenfactoryKeeper.GetAllowlist(s.Ctx, s.defaultDenom))
+}
+
+func (s *KeeperTestSuite) TestRestrictedTransfers() {
+	s.CreateDefaultDenom()
+	admin, allowed, other := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	hooks := s.App.TokenfactoryKeeper.Hooks()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 10))
+
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), other.String()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetRestricted(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetRestricted(admin.String(), s.defaultDenom, true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddToAllowlist(sdk.WrapSDKContext(s.Ctx), types.NewMsgAddToAllowlist(admin.String(), s.defaultDenom, []string{admin.String(), allowed.String()}))
+	s.Require().NoError(err)
+
+	// mint recipients must be on the allowlist
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), other.String()))
+	s.Require().ErrorIs(err, types.ErrNotAllowlisted)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), allowed.String()))
+	s.Require().NoError(err)
+
+	// both the sender and the recipient must be on the allowlist
+	s.Require().NoError(hooks.BlockBeforeSend(s.Ctx, allowed, admin, coins))
+	s.Require().ErrorIs(hooks.BlockBeforeSend(s.Ctx, allowed, other, coins), types.ErrNotAllowlisted)
+	s.Require().ErrorIs(hooks.BlockBeforeSend(s.Ctx, other, allowed, coins), types.ErrNotAllowlisted)
+
+	// the admin can still burn from addresses that aren't on the allowlist
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), other.String()))
+	s.Require().NoError(err)
+
+	// lifting the restriction unblocks the transfers
+	_, err = s.msgServer.SetRestricted(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetRestricted(admin.String(), s.defaultDenom, false))
+	s.Require().NoError(err)
+	s.Require().NoError(hooks.BlockBeforeSend(s.Ctx, allowed, other, coins))
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetDenomBacking returns the collateral backing of a specific denom, and whether the denom
+// is backed
+func (k Keeper) GetDenomBacking(ctx sdk.Context, denom string) (types.DenomBacking, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomBackingKey)
+	if bz == nil {
+		return types.DenomBacking{}, false
+	}
+
+	backing := types.DenomBacking{}
+	if err := proto.Unmarshal(bz, &backing); err != nil {
+		panic(err)
+	}
+	return backing, true
+}
+
+// IsDenomBacked returns whether a denom is backed by a collateral coin
+func (k Keeper) IsDenomBacked(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.DenomBackingKey)
+}
+
+func (k Keeper) setDenomBacking(ctx sdk.Context, denom string, backing types.DenomBacking) error {
+	err := backing.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&backing)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.DenomBackingKey, bz)
+	return nil
+}
+
+// backDenom makes a denom without supply backed by a collateral denom
+func (k Keeper) backDenom(ctx sdk.Context, denom, collateralDenom string) error {
+	if k.IsDenomBacked(ctx, denom) {
+		return types.ErrInvalidDenomBacking.Wrapf("denom %s is already backed", denom)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if !supply.IsZero() {
+		return types.ErrDenomHasSupply.Wrapf("supply: %s", supply)
+	}
+
+	return k.setDenomBacking(ctx, denom, types.DenomBacking{
+		CollateralDenom: collateralDenom,
+		Escrowed:        sdk.ZeroInt(),
+	})
+}
+
+// wrap locks the collateral of amount in escrow in the module account, and mints amount of
+// the backed denom to addr
+func (k Keeper) wrap(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	backing, found := k.GetDenomBacking(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrInvalidDenomBacking.Wrapf("denom %s is not backed", amount.Denom)
+	}
+
+	collateral := sdk.NewCoin(backing.CollateralDenom, amount.Amount)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(collateral))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	backing.Escrowed = backing.Escrowed.Add(amount.Amount)
+	err = k.setDenomBacking(ctx, amount.Denom, backing)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = k.mintTo(ctx, amount, addr.String())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return collateral, nil
+}
+
+// unwrap burns amount of a backed denom from addr, and releases its collateral from escrow
+// to addr
+func (k Keeper) unwrap(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	backing, found := k.GetDenomBacking(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrInvalidDenomBacking.Wrapf("denom %s is not backed", amount.Denom)
+	}
+
+	err := k.burnFrom(ctx, amount, addr.String())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	backing.Escrowed = backing.Escrowed.Sub(amount.Amount)
+	err = k.setDenomBacking(ctx, amount.Denom, backing)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	collateral := sdk.NewCoin(backing.CollateralDenom, amount.Amount)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(collateral))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return collateral, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/keeper"
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSetDenomBacking() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+
+	// only the admin can back a denom
+	_, err := s.msgServer.SetDenomBacking(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomBacking(holder.String(), s.defaultDenom, "uatom"))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// a denom with supply can't be backed
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetDenomBacking(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomBacking(admin.String(), s.defaultDenom, "uatom"))
+	s.Require().ErrorIs(err, types.ErrDenomHasSupply)
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetDenomBacking(sdk.WrapSDKContext(ctx), types.NewMsgSetDenomBacking(admin.String(), s.defaultDenom, "uatom"))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetDenomBacking{}), 1)
+
+	backingRes, err := s.queryClient.DenomBacking(s.Ctx.Context(), &types.QueryDenomBackingRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal("uatom", backingRes.Backing.CollateralDenom)
+	s.Require().True(backingRes.Backing.Escrowed.IsZero())
+
+	// a backed denom can't be backed again
+	_, err = s.msgServer.SetDenomBacking(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomBacking(admin.String(), s.defaultDenom, "uosmo"))
+	s.Require().ErrorIs(err, types.ErrInvalidDenomBacking)
+
+	// the admin can't mint or burn a backed denom
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrBackedDenom)
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrBackedDenom)
+}
+
+func (s *KeeperTestSuite) TestWrapUnwrap() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+	s.FundAcc(holder, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)))
+
+	// a denom that isn't backed can't be wrapped
+	_, err := s.msgServer.Wrap(sdk.WrapSDKContext(s.Ctx), types.NewMsgWrap(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrInvalidDenomBacking)
+
+	_, err = s.msgServer.SetDenomBacking(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomBacking(admin.String(), s.defaultDenom, "uatom"))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.Wrap(sdk.WrapSDKContext(ctx), types.NewMsgWrap(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 60)))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventWrap{}), 1)
+	s.Require().Equal(int64(40), s.App.BankKeeper.GetBalance(s.Ctx, holder, "uatom").Amount.Int64())
+	s.Require().Equal(int64(60), s.App.BankKeeper.GetBalance(s.Ctx, holder, s.defaultDenom).Amount.Int64())
+
+	// the collateral can't exceed the balance of the sender
+	_, err = s.msgServer.Wrap(sdk.WrapSDKContext(s.Ctx), types.NewMsgWrap(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 41)))
+	s.Require().Error(err)
+
+	ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.Unwrap(sdk.WrapSDKContext(ctx), types.NewMsgUnwrap(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 25)))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventUnwrap{}), 1)
+	s.Require().Equal(int64(65), s.App.BankKeeper.GetBalance(s.Ctx, holder, "uatom").Amount.Int64())
+	s.Require().Equal(int64(35), s.App.BankKeeper.GetBalance(s.Ctx, holder, s.defaultDenom).Amount.Int64())
+
+	backing, found := s.App.TokenfactoryKeeper.GetDenomBacking(s.Ctx, s.defaultDenom)
+	s.Require().True(found)
+	s.Require().Equal(int64(35), backing.Escrowed.Int64())
+	s.Require().Equal(int64(35), s.App.BankKeeper.GetSupply(s.Ctx, s.defaultDenom).Amount.Int64())
+
+	// an unwrap can't exceed the balance of the sender
+	_, err = s.msgServer.Unwrap(sdk.WrapSDKContext(s.Ctx), types.NewMsgUnwrap(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 36)))
+	s.Require().Error(err)
+
+	msg, broken := keeper.BackedSupplyInvariant(s.App.TokenfactoryKeeper)(s.Ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *KeeperTestSuite) TestWrapFactoryCollateral() {
+	s.CreateDefaultDenom()
+	admin, attacker, holder := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	// a factory denom administered by another account
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(attacker.String(), "collateral"))
+	s.Require().NoError(err)
+	collateralDenom := res.GetNewTokenDenom()
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(attacker.String(), sdk.NewInt64Coin(collateralDenom, 100), holder.String()))
+	s.Require().NoError(err)
+
+	// can't be collateral, as its admin controls its supply and balances
+	_, err = s.msgServer.SetDenomBacking(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomBacking(admin.String(), s.defaultDenom, collateralDenom))
+	s.Require().ErrorIs(err, types.ErrInvalidDenomBacking)
+
+	// and the admin of a factory denom held in escrow by the module account can't take it
+	// from the module account
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin(collateralDenom, 60))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromAccountToModule(s.Ctx, holder, types.ModuleName, escrowed))
+	_, err = s.msgServer.ForceTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceTransfer(attacker.String(), escrowed[0], moduleAddr.String(), attacker.String()))
+	s.Require().ErrorIs(err, types.ErrForceTransferModuleAccount)
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurnFrom(attacker.String(), escrowed[0], moduleAddr.String()))
+	s.Require().ErrorIs(err, types.ErrBurnFromModuleAccount)
+	s.Require().Equal(escrowed, s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddr))
+}
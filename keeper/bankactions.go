@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/osmosis-labs/tokenfactory/types"
 )
@@ -13,16 +14,27 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
-	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	addr, err := sdk.AccAddressFromBech32(mintTo)
 	if err != nil {
 		return err
 	}
 
-	addr, err := sdk.AccAddressFromBech32(mintTo)
+	err = k.checkAllowlisted(ctx, amount.Denom, addr)
+	if err != nil {
+		return err
+	}
+
+	err = k.checkMaxBalance(ctx, amount.Denom, addr, amount.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
 	}
 
+	k.trackBeforeSend(ctx, nil, addr, sdk.NewCoins(amount))
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
 		addr,
 		sdk.NewCoins(amount))
@@ -40,6 +52,7 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return err
 	}
 
+	k.trackBeforeSend(ctx, addr, nil, sdk.NewCoins(amount))
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
 		addr,
 		types.ModuleName,
@@ -51,6 +64,19 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 }
 
+// isModuleOrBlockedAccount returns whether addr is a module account, such as the module
+// account holding the vesting escrow and the collateral of backed denoms, or an address that
+// the bank module doesn't allow to receive funds. Admins can't burn or force transfer from or
+// to these accounts.
+func (k Keeper) isModuleOrBlockedAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.bankKeeper.BlockedAddr(addr) {
+		return true
+	}
+
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
+
 func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string, toAddr string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
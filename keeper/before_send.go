@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// Hooks implements the before-send bank hooks of the tokenfactory module. Chains whose
+// bank module supports before-send hooks register them with the bank keeper, so that the
+// module can follow the balance changes of factory denoms.
+type Hooks struct {
+	k Keeper
+}
+
+var _ types.BankHooks = Hooks{}
+
+// Hooks returns the before-send bank hooks of the tokenfactory module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// TrackBeforeSend tracks the balance changes of a send of factory denoms. Sends from or to
+// the module account are skipped, as the module tracks the balance changes of its own
+// mints, burns and escrow transfers itself.
+func (h Hooks) TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	if isModuleTransfer(from, to) {
+		return
+	}
+	h.k.trackBeforeSend(ctx, from, to, amount)
+}
+
+// BlockBeforeSend is called before every send, and returns an error if the send must be
+// blocked. It blocks the sends of restricted denoms between addresses that aren't on their
+// allowlists and the sends above the max balance of the recipient, and collects the
+// transfer fees of the sent factory denoms. Mints, burns and the other sends from or to the
+// module account are checked by the module itself.
+func (h Hooks) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if from == nil || to == nil || isModuleTransfer(from, to) {
+		return nil
+	}
+
+	err := h.k.checkRestrictedSend(ctx, from, to, amount)
+	if err != nil {
+		return err
+	}
+	err = h.k.checkMaxBalances(ctx, from, to, amount)
+	if err != nil {
+		return err
+	}
+	return h.k.collectTransferFees(ctx, from, to, amount)
+}
+
+// checkSendHooksRegistered returns an error if the app didn't register the send hooks, so
+// that the features enforced or tracked in the hooks can't be enabled while bank sends skip
+// them.
+func (k Keeper) checkSendHooksRegistered(feature string) error {
+	if !k.sendHooksRegistered {
+		return types.ErrSendHooksNotRegistered.Wrapf("%s requires the send hooks of the module to be registered with the bank keeper", feature)
+	}
+	return nil
+}
+
+// isModuleTransfer returns whether a send is from or to the module account
+func isModuleTransfer(from, to sdk.AccAddress) bool {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	return moduleAddr.Equals(from) || moduleAddr.Equals(to)
+}
+
+// trackBeforeSend tracks the balance changes of the factory denoms in amount, before they are
+// sent from from to to. A nil from is a mint, and a nil to is a burn.
+func (k Keeper) trackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) {
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") || !k.denomExists(ctx, coin.Denom) {
+			continue
+		}
+
+		if from != nil {
+			k.trackSnapshotBalance(ctx, coin.Denom, from)
+			k.settleDistributionRewards(ctx, coin.Denom, from)
+		}
+		if to != nil {
+			k.trackSnapshotBalance(ctx, coin.Denom, to)
+			k.settleDistributionRewards(ctx, coin.Denom, to)
+			k.setSnapshotHolder(ctx, coin.Denom, to)
+		}
+
+		// a send to the sender itself doesn't change its balance
+		if from != nil && to != nil && from.Equals(to) {
+			continue
+		}
+		if from != nil {
+			k.trackHolderBalance(ctx, coin.Denom, from, coin.Amount.Neg())
+		}
+		if to != nil {
+			k.trackHolderBalance(ctx, coin.Denom, to, coin.Amount)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetConversionRoute returns the conversion route of a source denom
+func (k Keeper) GetConversionRoute(ctx sdk.Context, sourceDenom string) (types.ConversionRoute, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetConversionRouteKey(sourceDenom))
+	if bz == nil {
+		return types.ConversionRoute{}, false
+	}
+
+	route := types.ConversionRoute{}
+	if err := proto.Unmarshal(bz, &route); err != nil {
+		panic(err)
+	}
+	return route, true
+}
+
+// GetAllConversionRoutes returns the conversion routes of all source denoms, including the
+// ones that can't be used anymore
+func (k Keeper) GetAllConversionRoutes(ctx sdk.Context) []types.ConversionRoute {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConversionRoutePrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	routes := []types.ConversionRoute{}
+	for ; iterator.Valid(); iterator.Next() {
+		route := types.ConversionRoute{}
+		if err := proto.Unmarshal(iterator.Value(), &route); err != nil {
+			panic(err)
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// GetActiveConversionRoutes returns the conversion routes that can currently be used
+func (k Keeper) GetActiveConversionRoutes(ctx sdk.Context) []types.ConversionRoute {
+	routes := []types.ConversionRoute{}
+	for _, route := range k.GetAllConversionRoutes(ctx) {
+		if k.checkConversionRoute(ctx, route) == nil {
+			routes = append(routes, route)
+		}
+	}
+	return routes
+}
+
+func (k Keeper) setConversionRoute(ctx sdk.Context, route types.ConversionRoute) error {
+	err := route.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&route)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetConversionRouteKey(route.SourceDenom), bz)
+	return nil
+}
+
+func (k Keeper) deleteConversionRoute(ctx sdk.Context, sourceDenom string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetConversionRouteKey(sourceDenom))
+}
+
+// deleteConversionRoutesOfDenom removes the conversion routes from and into denom, so that
+// they don't become usable again if the denom is created again
+func (k Keeper) deleteConversionRoutesOfDenom(ctx sdk.Context, denom string) {
+	k.deleteConversionRoute(ctx, denom)
+	for _, route := range k.GetAllConversionRoutes(ctx) {
+		if route.TargetDenom == denom {
+			k.deleteConversionRoute(ctx, route.SourceDenom)
+		}
+	}
+}
+
+// checkConversionRoute returns an error if a conversion route can't be used, because its
+// deadline passed, one of its denoms is frozen or backed, or its creator isn't the admin of
+// both denoms anymore
+func (k Keeper) checkConversionRoute(ctx sdk.Context, route types.ConversionRoute) error {
+	if route.IsExpired(ctx.BlockTime()) {
+		return types.ErrConversionRouteNotFound.Wrapf("route of %s expired at %s", route.SourceDenom, route.Deadline)
+	}
+
+	for _, denom := range []string{route.SourceDenom, route.TargetDenom} {
+		if !k.denomExists(ctx, denom) {
+			return types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+		}
+
+		authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return err
+		}
+		if authorityMetadata.GetAdmin() != route.Creator {
+			return types.ErrConversionRouteNotFound.Wrapf("admin of %s changed since the route of %s was registered", denom, route.SourceDenom)
+		}
+		if authorityMetadata.GetFrozen() {
+			return types.ErrDenomFrozen
+		}
+		if k.IsDenomBacked(ctx, denom) {
+			return types.ErrBackedDenom.Wrapf("denom: %s", denom)
+		}
+	}
+
+	return nil
+}
+
+// convert burns amount of a source denom from addr and mints the converted amount of the
+// target denom of its conversion route to addr
+func (k Keeper) convert(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	route, found := k.GetConversionRoute(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrConversionRouteNotFound.Wrapf("denom: %s", amount.Denom)
+	}
+
+	err := k.checkConversionRoute(ctx, route)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	converted := sdk.NewCoin(route.TargetDenom, route.ConvertAmount(amount.Amount))
+	if !converted.IsPositive() {
+		return sdk.Coin{}, types.ErrInvalidConversionRoute.Wrapf("%s converts to zero %s", amount, route.TargetDenom)
+	}
+
+	err = k.burnFrom(ctx, amount, addr.String())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = k.mintTo(ctx, converted, addr.String())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return converted, nil
+}
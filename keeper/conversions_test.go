@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestRegisterConversionRoute() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0]
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin.String(), "bitcoin2"))
+	s.Require().NoError(err)
+	targetDenom := res.GetNewTokenDenom()
+	otherRes, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[1].String(), "bitcoin2"))
+	s.Require().NoError(err)
+
+	// the sender must be the admin of both denoms
+	_, err = s.msgServer.RegisterConversionRoute(sdk.WrapSDKContext(s.Ctx), types.NewMsgRegisterConversionRoute(admin.String(), s.defaultDenom, otherRes.GetNewTokenDenom(), sdk.OneDec(), nil))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the deadline can't have passed
+	past := s.Ctx.BlockTime()
+	_, err = s.msgServer.RegisterConversionRoute(sdk.WrapSDKContext(s.Ctx), types.NewMsgRegisterConversionRoute(admin.String(), s.defaultDenom, targetDenom, sdk.OneDec(), &past))
+	s.Require().ErrorIs(err, types.ErrInvalidConversionRoute)
+
+	deadline := s.Ctx.BlockTime().Add(time.Hour)
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.RegisterConversionRoute(sdk.WrapSDKContext(ctx), types.NewMsgRegisterConversionRoute(admin.String(), s.defaultDenom, targetDenom, sdk.OneDec(), &deadline))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventRegisterConversionRoute{}), 1)
+
+	routesRes, err := s.queryClient.ConversionRoutes(s.Ctx.Context(), &types.QueryConversionRoutesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(routesRes.Routes, 1)
+	s.Require().Equal(targetDenom, routesRes.Routes[0].TargetDenom)
+
+	// expired routes and routes whose denoms changed admin aren't active
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetActiveConversionRoutes(s.Ctx.WithBlockTime(deadline)))
+
+	_, err = s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAdmin(admin.String(), targetDenom, s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetActiveConversionRoutes(s.Ctx))
+	s.Require().Len(s.App.TokenfactoryKeeper.GetAllConversionRoutes(s.Ctx), 1)
+}
+
+func (s *KeeperTestSuite) TestConvert() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin.String(), "bitcoin2"))
+	s.Require().NoError(err)
+	targetDenom := res.GetNewTokenDenom()
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), holder.String()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Convert(sdk.WrapSDKContext(s.Ctx), types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrConversionRouteNotFound)
+
+	// two and a half target tokens per source token
+	_, err = s.msgServer.RegisterConversionRoute(sdk.WrapSDKContext(s.Ctx), types.NewMsgRegisterConversionRoute(admin.String(), s.defaultDenom, targetDenom, sdk.NewDecWithPrec(25, 1), nil))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	convertRes, err := s.msgServer.Convert(sdk.WrapSDKContext(ctx), types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 11)))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventConvert{}), 1)
+	s.Require().Equal(sdk.NewInt64Coin(targetDenom, 27), convertRes.Converted)
+	s.Require().Equal(int64(89), s.App.BankKeeper.GetBalance(s.Ctx, holder, s.defaultDenom).Amount.Int64())
+	s.Require().Equal(int64(27), s.App.BankKeeper.GetBalance(s.Ctx, holder, targetDenom).Amount.Int64())
+	s.Require().Equal(int64(89), s.App.BankKeeper.GetSupply(s.Ctx, s.defaultDenom).Amount.Int64())
+
+	// a conversion can't exceed the balance of the sender
+	_, err = s.msgServer.Convert(sdk.WrapSDKContext(s.Ctx), types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 90)))
+	s.Require().Error(err)
+
+	// a frozen denom can't be converted
+	_, err = s.msgServer.GovFreezeDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgGovFreezeDenom(s.App.TokenfactoryKeeper.GetAuthority(), targetDenom, true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Convert(sdk.WrapSDKContext(s.Ctx), types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+}
+
+// TestDeleteDenomRemovesConversionRoutes tests that deleting a denom removes the conversion
+// routes from and into it, so that they don't come back when the denom is created again
+func (s *KeeperTestSuite) TestDeleteDenomRemovesConversionRoutes() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	res, err := s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(admin, "bitcoin2"))
+	s.Require().NoError(err)
+	otherDenom := res.GetNewTokenDenom()
+	res, err = s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(admin, "bitcoin3"))
+	s.Require().NoError(err)
+	unrelatedDenom := res.GetNewTokenDenom()
+
+	for _, route := range [][2]string{{s.defaultDenom, otherDenom}, {otherDenom, s.defaultDenom}, {unrelatedDenom, otherDenom}} {
+		_, err = s.msgServer.RegisterConversionRoute(goCtx, types.NewMsgRegisterConversionRoute(admin, route[0], route[1], sdk.OneDec(), nil))
+		s.Require().NoError(err)
+	}
+
+	_, err = s.msgServer.DeleteDenom(goCtx, types.NewMsgDeleteDenom(admin, s.defaultDenom, false))
+	s.Require().NoError(err)
+	routes := s.App.TokenfactoryKeeper.GetAllConversionRoutes(s.Ctx)
+	s.Require().Len(routes, 1)
+	s.Require().Equal(unrelatedDenom, routes[0].SourceDenom)
+
+	// the denom created again has no routes from or into it
+	_, err = s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	s.Require().Len(s.App.TokenfactoryKeeper.GetActiveConversionRoutes(s.Ctx), 1)
+}
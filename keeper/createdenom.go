@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -11,23 +9,45 @@ import (
 
 // ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount
 func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
-	denom, err := k.validateCreateDenom(ctx, creatorAddr, subdenom)
+	params := k.GetParams(ctx)
+
+	denom, err := k.validateCreateDenom(ctx, params, creatorAddr, subdenom)
 	if err != nil {
 		return "", err
 	}
 
-	err = k.chargeForCreateDenom(ctx, creatorAddr)
+	creationFee, err := k.chargeForCreateDenom(ctx, params, creatorAddr)
 	if err != nil {
 		return "", err
 	}
 
-	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
+	err = k.createDenomAfterValidation(ctx, creatorAddr, denom, creationFee)
+	if err != nil {
+		return "", err
+	}
+
+	if params.DenomCreationFeeIsDeposit && !creationFee.IsZero() {
+		err = k.setDenomDeposit(ctx, denom, types.DenomDeposit{
+			Depositor: creatorAddr,
+			Amount:    creationFee,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = k.trackCreation(ctx, params, sdk.MustAccAddressFromBech32(creatorAddr))
 	return denom, err
 }
 
 // Runs CreateDenom logic after the charge and all denom validation has been handled.
 // Made into a second function for genesis initialization.
-func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string, denom string) (err error) {
+func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string, denom string, creationFee sdk.Coins) (err error) {
+	creator, err := sdk.AccAddressFromBech32(creatorAddr)
+	if err != nil {
+		return err
+	}
+
 	_, exists := k.bankKeeper.GetDenomMetaData(ctx, denom)
 	if !exists {
 		denomMetaData := banktypes.Metadata{
@@ -49,43 +69,71 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string,
 		return err
 	}
 
-	k.addDenomFromCreator(ctx, creatorAddr, denom)
+	creationRecord := types.DenomCreationRecord{
+		Creator:        creatorAddr,
+		CreationHeight: ctx.BlockHeight(),
+		CreationTime:   ctx.BlockTime(),
+		CreationFee:    creationFee,
+	}
+	err = k.setDenomCreationRecord(ctx, denom, creationRecord)
+	if err != nil {
+		return err
+	}
+
+	k.addDenomFromCreator(ctx, creator, denom)
 	return nil
 }
 
-func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
-	// Temporary check until IBC bug is sorted out
-	if k.bankKeeper.HasSupply(ctx, subdenom) {
-		return "", fmt.Errorf("temporary error until IBC bug is sorted out, " +
-			"can't create subdenoms that are the same as a native denom")
+func (k Keeper) validateCreateDenom(ctx sdk.Context, params types.Params, creatorAddr string, subdenom string) (newTokenDenom string, err error) {
+	denom, err := types.GetTokenDenom(creatorAddr, subdenom)
+	if err != nil {
+		return "", err
 	}
 
-	denom, err := types.GetTokenDenom(creatorAddr, subdenom)
+	creator, err := sdk.AccAddressFromBech32(creatorAddr)
 	if err != nil {
 		return "", err
 	}
 
-	_, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
-	if found {
+	if k.isReservedSubdenom(params, subdenom) && !k.isReservedSubdenomExempt(params, creator) {
+		return "", types.ErrSubdenomReserved.Wrapf("subdenom: %s", subdenom)
+	}
+
+	if err := k.checkCreationLimits(ctx, params, creator); err != nil {
+		return "", err
+	}
+
+	if k.denomExists(ctx, denom) {
 		return "", types.ErrDenomExists
 	}
 
+	if k.isDenomTombstoned(ctx, denom) {
+		return "", types.ErrDenomTombstoned.Wrapf("denom: %s", denom)
+	}
+
 	return denom, nil
 }
 
-func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string) (err error) {
-	params := k.GetParams(ctx)
-
+// chargeForCreateDenom charges the denom creation fee and gas, and returns the
+// fee that was charged.
+func (k Keeper) chargeForCreateDenom(ctx sdk.Context, params types.Params, creatorAddr string) (creationFee sdk.Coins, err error) {
 	// if DenomCreationFee is non-zero, transfer the tokens from the creator
-	// account to community pool
+	// account to community pool, or to the module account if the fee is a
+	// deposit
 	if params.DenomCreationFee != nil {
 		accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if err := k.distrKeeper.FundCommunityPool(ctx, params.DenomCreationFee, accAddr); err != nil {
-			return err
+		if params.DenomCreationFeeIsDeposit {
+			k.trackBeforeSend(ctx, accAddr, nil, params.DenomCreationFee)
+			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, params.DenomCreationFee)
+		} else {
+			err = k.distrKeeper.FundCommunityPool(ctx, params.DenomCreationFee, accAddr)
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -94,5 +142,5 @@ func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string) (err e
 		ctx.GasMeter().ConsumeGas(params.DenomCreationGasConsume, "consume denom creation gas")
 	}
 
-	return nil
+	return params.DenomCreationFee, nil
 }
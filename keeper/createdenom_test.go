@@ -67,6 +67,30 @@ func (s *KeeperTestSuite) TestMsgCreateDenom() {
 	s.Require().NoError(err)
 	s.Require().NotEmpty(res.GetNewTokenDenom())
 
+	// Make sure that the creation record is set
+	recordRes, err := s.queryClient.DenomCreationRecord(s.Ctx.Context(), &types.QueryDenomCreationRecordRequest{
+		Denom: res.GetNewTokenDenom(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomCreationRecord{
+		Creator:        s.TestAccs[1].String(),
+		CreationHeight: s.Ctx.BlockHeight(),
+		CreationTime:   s.Ctx.BlockTime(),
+		CreationFee:    denomCreationFee,
+	}, recordRes.CreationRecord)
+
+	// Make sure that bank metadata set by another module doesn't make a denom exist
+	denom, err := types.GetTokenDenom(s.TestAccs[1].String(), "litecoin")
+	s.Require().NoError(err)
+	bankKeeper.SetDenomMetaData(s.Ctx, banktypes.Metadata{Base: denom, Display: denom})
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[1].String(), sdk.NewInt64Coin(denom, 10)))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+	_, err = s.queryClient.DenomCreationRecord(s.Ctx.Context(), &types.QueryDenomCreationRecordRequest{Denom: denom})
+	s.Require().Error(err)
+	s.FundAcc(s.TestAccs[1], denomCreationFee)
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[1].String(), "litecoin"))
+	s.Require().NoError(err)
+
 	// Make sure that an address with a "/" in it can't create denoms
 	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom("osmosis.eth/creator", "bitcoin"))
 	s.Require().Error(err)
@@ -80,6 +104,7 @@ func (s *KeeperTestSuite) TestCreateDenom() {
 		twoDenomCreationFee     = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(50000000)), sdk.NewCoin(secondaryDenom, sdk.NewInt(50000000)))}
 		nilCreationFee          = types.Params{DenomCreationFee: nil}
 		largeCreationFee        = types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewCoin(primaryDenom, sdk.NewInt(5000000000)))}
+		reservedSubdenoms       = types.Params{DenomCreationFee: defaultDenomCreationFee.DenomCreationFee, ReservedSubdenoms: []string{"uatom", "usdc"}}
 	)
 
 	for _, tc := range []struct {
@@ -135,6 +160,18 @@ func (s *KeeperTestSuite) TestCreateDenom() {
 			subdenom:         "bit/***///&&&/coin",
 			valid:            false,
 		},
+		{
+			desc:             "subdenom is reserved",
+			denomCreationFee: reservedSubdenoms,
+			subdenom:         "usdc",
+			valid:            false,
+		},
+		{
+			desc:             "success case: subdenom with the same name as a native denom",
+			denomCreationFee: defaultDenomCreationFee,
+			subdenom:         primaryDenom,
+			valid:            true,
+		},
 	} {
 		s.SetupTest()
 		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
@@ -231,7 +268,7 @@ func (s *KeeperTestSuite) TestGasConsume() {
 		s.SetupTest()
 		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
 			// set params with the gas consume amount
-			s.App.TokenfactoryKeeper.SetParams(s.Ctx, types.NewParams(nil, tc.gasConsume))
+			s.App.TokenfactoryKeeper.SetParams(s.Ctx, types.NewParams(nil, tc.gasConsume, nil, 0, 0, 0, false, nil, nil, 0, nil, 0))
 
 			// amount of gas consumed prior to the denom creation
 			gasConsumedBefore := s.Ctx.GasMeter().GasConsumed()
@@ -250,3 +287,124 @@ func (s *KeeperTestSuite) TestGasConsume() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSubdenomAvailability() {
+	params := s.App.TokenfactoryKeeper.GetParams(s.Ctx)
+	params.ReservedSubdenoms = []string{"uatom"}
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+
+	_, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc        string
+		creator     string
+		subdenom    string
+		available   bool
+		expectedErr error
+	}{
+		{
+			desc:      "available subdenom",
+			creator:   s.TestAccs[0].String(),
+			subdenom:  "litecoin",
+			available: true,
+		},
+		{
+			desc:        "subdenom already created by the creator",
+			creator:     s.TestAccs[0].String(),
+			subdenom:    "bitcoin",
+			expectedErr: types.ErrDenomExists,
+		},
+		{
+			desc:      "subdenom created by another creator",
+			creator:   s.TestAccs[1].String(),
+			subdenom:  "bitcoin",
+			available: true,
+		},
+		{
+			desc:        "reserved subdenom",
+			creator:     s.TestAccs[0].String(),
+			subdenom:    "uatom",
+			expectedErr: types.ErrSubdenomReserved,
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			res, err := s.queryClient.SubdenomAvailability(s.Ctx.Context(), &types.QuerySubdenomAvailabilityRequest{
+				Creator:  tc.creator,
+				Subdenom: tc.subdenom,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.available, res.Available)
+			if tc.available {
+				expectedDenom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedDenom, res.Denom)
+				s.Require().Empty(res.Reason)
+			} else {
+				s.Require().Contains(res.Reason, tc.expectedErr.Error())
+			}
+		})
+	}
+
+	// an invalid creator address is rejected
+	_, err = s.queryClient.SubdenomAvailability(s.Ctx.Context(), &types.QuerySubdenomAvailabilityRequest{
+		Creator:  "invalid",
+		Subdenom: "bitcoin",
+	})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestCreationLimits() {
+	params := s.App.TokenfactoryKeeper.GetParams(s.Ctx)
+	params.MaxDenomsPerCreator = 3
+	params.MaxCreationsPerWindow = 2
+	params.CreationWindowBlocks = 10
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+
+	creator := s.TestAccs[0].String()
+	createDenom := func(creator, subdenom string) error {
+		_, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(creator, subdenom))
+		return err
+	}
+
+	s.Require().NoError(createDenom(creator, "bitcoin"))
+	s.Require().NoError(createDenom(creator, "litecoin"))
+
+	// the creation rate limit is reached within the window
+	s.Require().ErrorIs(createDenom(creator, "dogecoin"), types.ErrCreationRateLimited)
+	res, err := s.queryClient.SubdenomAvailability(s.Ctx.Context(), &types.QuerySubdenomAvailabilityRequest{
+		Creator:  creator,
+		Subdenom: "dogecoin",
+	})
+	s.Require().NoError(err)
+	s.Require().False(res.Available)
+
+	// other creators are not affected
+	s.Require().NoError(createDenom(s.TestAccs[1].String(), "dogecoin"))
+
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 9)
+	s.Require().ErrorIs(createDenom(creator, "dogecoin"), types.ErrCreationRateLimited)
+
+	// a new window starts after CreationWindowBlocks blocks
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.Require().NoError(createDenom(creator, "dogecoin"))
+
+	// the maximum number of denoms per creator is reached
+	s.Require().ErrorIs(createDenom(creator, "ether"), types.ErrTooManyDenoms)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 100)
+	s.Require().ErrorIs(createDenom(creator, "ether"), types.ErrTooManyDenoms)
+
+	// deleting a denom frees up a slot
+	_, err = s.msgServer.DeleteDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgDeleteDenom(creator, fmt.Sprintf("factory/%s/bitcoin", creator), false))
+	s.Require().NoError(err)
+	s.Require().NoError(createDenom(creator, "ether"))
+	s.Require().ErrorIs(createDenom(creator, "solana"), types.ErrTooManyDenoms)
+
+	// the limits can be lifted again
+	params.MaxDenomsPerCreator = 0
+	params.MaxCreationsPerWindow = 0
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+	for _, subdenom := range []string{"solana", "cardano", "polkadot"} {
+		s.Require().NoError(createDenom(creator, subdenom))
+	}
+}
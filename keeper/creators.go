@@ -1,15 +1,34 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
 )
 
-func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator, denom string) {
+func (k Keeper) addDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
 	store := k.GetCreatorPrefixStore(ctx, creator)
+	if store.Has([]byte(denom)) {
+		return
+	}
+
 	store.Set([]byte(denom), []byte(denom))
+	k.setDenomCountFromCreator(ctx, creator, k.getDenomCountFromCreator(ctx, creator)+1)
 }
 
-func (k Keeper) getDenomsFromCreator(ctx sdk.Context, creator string) []string {
+func (k Keeper) removeDenomFromCreator(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	store := k.GetCreatorPrefixStore(ctx, creator)
+	if !store.Has([]byte(denom)) {
+		return
+	}
+
+	store.Delete([]byte(denom))
+	k.setDenomCountFromCreator(ctx, creator, k.getDenomCountFromCreator(ctx, creator)-1)
+}
+
+func (k Keeper) getDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) []string {
 	store := k.GetCreatorPrefixStore(ctx, creator)
 
 	iterator := store.Iterator(nil, nil)
@@ -22,6 +41,101 @@ func (k Keeper) getDenomsFromCreator(ctx sdk.Context, creator string) []string {
 	return denoms
 }
 
+// getDenomCountFromCreator returns the number of denoms created by creator. The count is
+// stored next to the denoms, so that checking MaxDenomsPerCreator doesn't iterate over them.
+func (k Keeper) getDenomCountFromCreator(ctx sdk.Context, creator sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCreatorDenomCountKey(creator))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setDenomCountFromCreator(ctx sdk.Context, creator sdk.AccAddress, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.GetCreatorDenomCountKey(creator))
+		return
+	}
+	store.Set(types.GetCreatorDenomCountKey(creator), sdk.Uint64ToBigEndian(count))
+}
+
+// getCreatorCreationWindow returns the creation window of creator at the current block
+// height. A new window is started if the last one has ended.
+func (k Keeper) getCreatorCreationWindow(ctx sdk.Context, creator sdk.AccAddress, windowBlocks uint64) types.CreatorCreationWindow {
+	window := types.CreatorCreationWindow{WindowStartHeight: ctx.BlockHeight(), Creator: creator.String()}
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCreatorCreationWindowKey(creator))
+	if bz == nil {
+		return window
+	}
+
+	stored := types.CreatorCreationWindow{}
+	if err := proto.Unmarshal(bz, &stored); err != nil {
+		panic(err)
+	}
+	if uint64(ctx.BlockHeight()-stored.WindowStartHeight) >= windowBlocks {
+		return window
+	}
+	return stored
+}
+
+func (k Keeper) setCreatorCreationWindow(ctx sdk.Context, creator sdk.AccAddress, window types.CreatorCreationWindow) error {
+	bz, err := proto.Marshal(&window)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetCreatorCreationWindowKey(creator), bz)
+	return nil
+}
+
+// GetAllCreatorCreationWindows returns the stored creation windows of all creators
+func (k Keeper) GetAllCreatorCreationWindows(ctx sdk.Context) []types.CreatorCreationWindow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreatorCreationWindowPrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	windows := []types.CreatorCreationWindow{}
+	for ; iterator.Valid(); iterator.Next() {
+		window := types.CreatorCreationWindow{}
+		if err := proto.Unmarshal(iterator.Value(), &window); err != nil {
+			panic(err)
+		}
+		windows = append(windows, window)
+	}
+	return windows
+}
+
+// checkCreationLimits returns an error if creator can't create another denom because of
+// the maximum number of denoms per creator or the creation rate limit.
+func (k Keeper) checkCreationLimits(ctx sdk.Context, params types.Params, creator sdk.AccAddress) error {
+	if params.MaxDenomsPerCreator != 0 && k.getDenomCountFromCreator(ctx, creator) >= params.MaxDenomsPerCreator {
+		return types.ErrTooManyDenoms.Wrapf("maximum is %d", params.MaxDenomsPerCreator)
+	}
+
+	if params.MaxCreationsPerWindow != 0 {
+		window := k.getCreatorCreationWindow(ctx, creator, params.CreationWindowBlocks)
+		if window.Creations >= params.MaxCreationsPerWindow {
+			return types.ErrCreationRateLimited.Wrapf("maximum is %d per %d blocks, window started at height %d",
+				params.MaxCreationsPerWindow, params.CreationWindowBlocks, window.WindowStartHeight)
+		}
+	}
+
+	return nil
+}
+
+// trackCreation counts a denom creation of creator towards the creation rate limit
+func (k Keeper) trackCreation(ctx sdk.Context, params types.Params, creator sdk.AccAddress) error {
+	if params.MaxCreationsPerWindow == 0 {
+		return nil
+	}
+
+	window := k.getCreatorCreationWindow(ctx, creator, params.CreationWindowBlocks)
+	window.Creations++
+	return k.setCreatorCreationWindow(ctx, creator, window)
+}
+
 func (k Keeper) GetAllDenomsIterator(ctx sdk.Context) sdk.Iterator {
 	return k.GetCreatorsPrefixStore(ctx).Iterator(nil, nil)
 }
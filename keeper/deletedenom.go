@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// DeleteDenom removes a denom with a zero total supply and no unclaimed rewards from the
+// module, and refunds its creation deposit. The bank metadata of the denom is kept, as the bank module doesn't
+// support removing it.
+func (k Keeper) DeleteDenom(ctx sdk.Context, denom string) (refundedDeposit sdk.Coins, err error) {
+	creationRecord, found := k.GetDenomCreationRecord(ctx, denom)
+	if !found {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if !supply.IsZero() {
+		return nil, types.ErrDenomHasSupply.Wrapf("supply: %s", supply)
+	}
+
+	err = k.deleteDistribution(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	refundedDeposit, err = k.refundDenomDeposit(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	creator, err := sdk.AccAddressFromBech32(creationRecord.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	denomStore := k.GetDenomPrefixStore(ctx, denom)
+	denomStore.Delete(types.DenomAuthorityMetadataKey)
+	denomStore.Delete(types.DenomCreationRecordKey)
+	denomStore.Delete(types.DenomTokenProfileKey)
+	denomStore.Delete(types.DenomBackingKey)
+	denomStore.Delete(types.DenomTransferFeeKey)
+	denomStore.Delete(types.DenomMaxBalanceKey)
+	k.deleteAllowlist(ctx, denom)
+	k.deleteAllowances(ctx, denom)
+	k.deleteSnapshots(ctx, denom)
+	k.disableHolderIndex(ctx, denom)
+	k.deleteConversionRoutesOfDenom(ctx, denom)
+	k.deleteMintSchedules(ctx, denom)
+	k.deleteVestingSchedules(ctx, denom)
+	k.removeDenomFromCreator(ctx, creator, denom)
+
+	return refundedDeposit, nil
+}
+
+// isDenomTombstoned returns whether denom was deleted with a tombstone, so that it can't
+// be created again
+func (k Keeper) isDenomTombstoned(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetDenomTombstoneKey(denom))
+}
+
+// setDenomTombstone prevents denom from being created again
+func (k Keeper) setDenomTombstone(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Set(types.GetDenomTombstoneKey(denom), []byte{})
+}
+
+// GetTombstonedDenoms returns all denoms that were deleted with a tombstone
+func (k Keeper) GetTombstonedDenoms(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTombstonePrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetDenomCreationRecord returns the creation record of a specific denom, and
+// whether the denom exists.
+func (k Keeper) GetDenomCreationRecord(ctx sdk.Context, denom string) (types.DenomCreationRecord, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomCreationRecordKey)
+	if bz == nil {
+		return types.DenomCreationRecord{}, false
+	}
+
+	record := types.DenomCreationRecord{}
+	if err := proto.Unmarshal(bz, &record); err != nil {
+		panic(err)
+	}
+	return record, true
+}
+
+// setDenomCreationRecord stores the creation record for a specific denom
+func (k Keeper) setDenomCreationRecord(ctx sdk.Context, denom string, record types.DenomCreationRecord) error {
+	err := record.Validate()
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.DenomCreationRecordKey, bz)
+	return nil
+}
+
+// denomExists returns whether denom was created through the tokenfactory module
+func (k Keeper) denomExists(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.DenomCreationRecordKey)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetDenomDeposit returns the refundable creation deposit of a specific denom, and
+// whether the denom has one.
+func (k Keeper) GetDenomDeposit(ctx sdk.Context, denom string) (types.DenomDeposit, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomDepositKey)
+	if bz == nil {
+		return types.DenomDeposit{}, false
+	}
+
+	deposit := types.DenomDeposit{}
+	if err := proto.Unmarshal(bz, &deposit); err != nil {
+		panic(err)
+	}
+	return deposit, true
+}
+
+// setDenomDeposit stores the creation deposit of a specific denom. The deposited coins
+// must already be held by the module account.
+func (k Keeper) setDenomDeposit(ctx sdk.Context, denom string, deposit types.DenomDeposit) error {
+	err := deposit.Validate()
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	bz, err := proto.Marshal(&deposit)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.DenomDepositKey, bz)
+	return nil
+}
+
+// refundDenomDeposit returns the creation deposit of denom from the module account to
+// its depositor.
+func (k Keeper) refundDenomDeposit(ctx sdk.Context, denom string) (sdk.Coins, error) {
+	deposit, found := k.GetDenomDeposit(ctx, denom)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !deposit.Amount.IsZero() {
+		k.trackBeforeSend(ctx, nil, depositor, deposit.Amount)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.DenomDepositKey)
+	return deposit.Amount, nil
+}
+
+// forfeitDenomDeposit transfers the creation deposit of denom from the module account to
+// the community pool.
+func (k Keeper) forfeitDenomDeposit(ctx sdk.Context, denom string) (sdk.Coins, error) {
+	deposit, found := k.GetDenomDeposit(ctx, denom)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	if !deposit.Amount.IsZero() {
+		moduleAddr := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+		err := k.distrKeeper.FundCommunityPool(ctx, deposit.Amount, moduleAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.DenomDepositKey)
+	return deposit.Amount, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) setDenomCreationDeposit(deposit sdk.Coins) {
+	params := s.App.TokenfactoryKeeper.GetParams(s.Ctx)
+	params.DenomCreationFee = deposit
+	params.DenomCreationFeeIsDeposit = true
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) TestDeleteDenomRefundsDeposit() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000000))
+	s.setDenomCreationDeposit(deposit)
+	s.FundAcc(s.TestAccs[0], deposit)
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	// the deposit is held by the module account
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], "uosmo").IsZero())
+	s.Require().Equal(deposit, s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddr))
+	depositRes, err := s.queryClient.DenomDeposit(s.Ctx.Context(), &types.QueryDenomDepositRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomDeposit{Depositor: s.TestAccs[0].String(), Amount: deposit}, depositRes.Deposit)
+
+	// a denom with supply can't be deleted
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 10)))
+	s.Require().NoError(err)
+	_, err = s.App.TokenfactoryKeeper.DeleteDenom(s.Ctx, denom)
+	s.Require().ErrorIs(err, types.ErrDenomHasSupply)
+
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 10)))
+	s.Require().NoError(err)
+	refunded, err := s.App.TokenfactoryKeeper.DeleteDenom(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(deposit, refunded)
+
+	// the deposit is refunded and the denom is gone
+	s.Require().Equal(deposit, s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[0]))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddr).IsZero())
+	_, found := s.App.TokenfactoryKeeper.GetDenomDeposit(s.Ctx, denom)
+	s.Require().False(found)
+	_, found = s.App.TokenfactoryKeeper.GetDenomCreationRecord(s.Ctx, denom)
+	s.Require().False(found)
+	denomsRes, err := s.queryClient.DenomsFromCreator(s.Ctx.Context(), &types.QueryDenomsFromCreatorRequest{Creator: s.TestAccs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(denomsRes.Denoms)
+
+	_, err = s.App.TokenfactoryKeeper.DeleteDenom(s.Ctx, denom)
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
+
+func (s *KeeperTestSuite) TestDelistDenomForfeitsDeposit() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000000))
+	s.setDenomCreationDeposit(deposit)
+	s.FundAcc(s.TestAccs[0], deposit)
+	authority := s.App.TokenfactoryKeeper.GetAuthority()
+
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	// only the authority can delist a denom
+	_, err = s.msgServer.DelistDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgDelistDenom(s.TestAccs[0].String(), denom))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.DelistDenom(sdk.WrapSDKContext(ctx), types.NewMsgDelistDenom(authority, denom))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventDelistDenom{}), 1)
+
+	// the deposit goes to the community pool and the admin is removed
+	communityPoolAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	s.Require().Equal(sdk.NewDecCoinsFromCoins(deposit...), communityPoolAfter.Sub(communityPoolBefore))
+	_, found := s.App.TokenfactoryKeeper.GetDenomDeposit(s.Ctx, denom)
+	s.Require().False(found)
+	authorityMetadata, err := s.App.TokenfactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Empty(authorityMetadata.Admin)
+
+	// a delisted denom can be deleted without refunding anything
+	refunded, err := s.App.TokenfactoryKeeper.DeleteDenom(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().True(refunded.IsZero())
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[0]).IsZero())
+
+	_, err = s.msgServer.DelistDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgDelistDenom(authority, denom))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+}
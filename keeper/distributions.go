@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetDistribution returns the reward distribution state of a specific denom, and whether
+// rewards were ever distributed to its holders.
+func (k Keeper) GetDistribution(ctx sdk.Context, denom string) (types.Distribution, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomDistributionKey)
+	if bz == nil {
+		return types.Distribution{}, false
+	}
+
+	distribution := types.Distribution{}
+	if err := proto.Unmarshal(bz, &distribution); err != nil {
+		panic(err)
+	}
+	return distribution, true
+}
+
+func (k Keeper) setDistribution(ctx sdk.Context, denom string, distribution types.Distribution) error {
+	bz, err := proto.Marshal(&distribution)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.DenomDistributionKey, bz)
+	return nil
+}
+
+// GetDistributionHolder returns the stored reward state of a holder of a denom. A holder whose
+// rewards were never settled has a zero reward per token.
+func (k Keeper) GetDistributionHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) types.DistributionHolder {
+	holder, _ := k.getDistributionHolder(ctx, denom, addr)
+	return holder
+}
+
+func (k Keeper) getDistributionHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) (types.DistributionHolder, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetDistributionHolderKey(addr))
+	if bz == nil {
+		return types.DistributionHolder{Address: addr.String()}, false
+	}
+
+	holder := types.DistributionHolder{}
+	if err := proto.Unmarshal(bz, &holder); err != nil {
+		panic(err)
+	}
+	return holder, true
+}
+
+// GetDistributionHolders returns the reward states of all the holders of a denom
+func (k Keeper) GetDistributionHolders(ctx sdk.Context, denom string) []types.DistributionHolder {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomDistributionHolderPrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	holders := []types.DistributionHolder{}
+	for ; iterator.Valid(); iterator.Next() {
+		holder := types.DistributionHolder{}
+		if err := proto.Unmarshal(iterator.Value(), &holder); err != nil {
+			panic(err)
+		}
+		holders = append(holders, holder)
+	}
+	return holders
+}
+
+func (k Keeper) setDistributionHolder(ctx sdk.Context, denom string, holder types.DistributionHolder) error {
+	bz, err := proto.Marshal(&holder)
+	if err != nil {
+		return err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(holder.Address)
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetDistributionHolderKey(addr), bz)
+	return nil
+}
+
+// getDistributionSupply returns the supply of denom that takes part in its reward
+// distributions, which excludes the tokens held in escrow by the module account
+func (k Keeper) getDistributionSupply(ctx sdk.Context, denom string) sdk.Int {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	escrowed := k.bankKeeper.GetBalance(ctx, moduleAddr, denom)
+	return k.bankKeeper.GetSupply(ctx, denom).Amount.Sub(escrowed.Amount)
+}
+
+// depositDistribution sends amount from sender to the module account, and distributes it
+// pro-rata to the current holders of denom by increasing its reward per token.
+func (k Keeper) depositDistribution(ctx sdk.Context, sender sdk.AccAddress, denom string, amount sdk.Coins) error {
+	supply := k.getDistributionSupply(ctx, denom)
+	if !supply.IsPositive() {
+		return types.ErrInvalidDistribution.Wrapf("denom %s has no holders", denom)
+	}
+
+	// claims are sent by the module account, which doesn't pay transfer fees
+	for _, coin := range amount {
+		if fee, found := k.GetTransferFee(ctx, coin.Denom); found && fee.BasisPoints != 0 {
+			return types.ErrInvalidDistribution.Wrapf("reward denom %s has a transfer fee", coin.Denom)
+		}
+	}
+
+	increase := sdk.NewDecCoinsFromCoins(amount...).QuoDecTruncate(supply.ToDec())
+	if increase.IsZero() {
+		return types.ErrInvalidDistribution.Wrapf("amount %s is too small for a supply of %s", amount, supply)
+	}
+
+	k.trackBeforeSend(ctx, sender, nil, amount)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	distribution, _ := k.GetDistribution(ctx, denom)
+	distribution.RewardPerToken = distribution.RewardPerToken.Add(increase...)
+	return k.setDistribution(ctx, denom, distribution)
+}
+
+// accrueDistributionRewards returns the reward state of addr, with the rewards accrued by
+// its current balance since its rewards were last settled added to its pending rewards.
+//
+// The rewards of a holder are settled on every tracked change of its balance once the denom
+// has a distribution, so a holder without a reward state either held its balance since
+// before the first distribution, and accrues from a zero reward per token, or was never seen
+// receiving the denom. The balance of the latter wasn't tracked, so it only accrues rewards
+// from the current reward per token.
+func (k Keeper) accrueDistributionRewards(ctx sdk.Context, denom string, distribution types.Distribution, addr sdk.AccAddress) types.DistributionHolder {
+	holder, found := k.getDistributionHolder(ctx, denom, addr)
+	if !found && !k.isSnapshotHolder(ctx, denom, addr) {
+		holder.RewardPerToken = distribution.RewardPerToken
+	}
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom).Amount
+	if balance.IsPositive() {
+		accrued := distribution.RewardPerToken.Sub(holder.RewardPerToken).MulDecTruncate(balance.ToDec())
+		holder.Pending = holder.Pending.Add(accrued...)
+	}
+	holder.RewardPerToken = distribution.RewardPerToken
+	return holder
+}
+
+// settleDistributionRewards adds the rewards accrued by addr since its rewards were last
+// settled to its pending rewards. It must be called before the balance of addr changes.
+func (k Keeper) settleDistributionRewards(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	distribution, found := k.GetDistribution(ctx, denom)
+	if !found {
+		return
+	}
+
+	holder := k.accrueDistributionRewards(ctx, denom, distribution, addr)
+	err := k.setDistributionHolder(ctx, denom, holder)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetPendingRewards returns the distributed rewards of addr for holding denom that can be
+// claimed
+func (k Keeper) GetPendingRewards(ctx sdk.Context, denom string, addr sdk.AccAddress) sdk.Coins {
+	distribution, found := k.GetDistribution(ctx, denom)
+	if !found {
+		return sdk.NewCoins()
+	}
+
+	holder := k.accrueDistributionRewards(ctx, denom, distribution, addr)
+	rewards, _ := holder.Pending.TruncateDecimal()
+	return rewards
+}
+
+// claimDistribution sends the pending rewards of addr for holding denom from the module
+// account to addr. The decimal remainders of the rewards stay pending, and so do the rewards
+// of denoms that addr can't receive, because it isn't on the allowlist of the reward denom or
+// would exceed its max balance.
+func (k Keeper) claimDistribution(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdk.Coins, error) {
+	distribution, found := k.GetDistribution(ctx, denom)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	holder := k.accrueDistributionRewards(ctx, denom, distribution, addr)
+	pending, remainder := holder.Pending.TruncateDecimal()
+	rewards := sdk.NewCoins()
+	for _, coin := range pending {
+		if k.checkAllowlisted(ctx, coin.Denom, addr) != nil || k.checkMaxBalance(ctx, coin.Denom, addr, coin.Amount) != nil {
+			remainder = remainder.Add(sdk.NewDecCoinFromCoin(coin))
+			continue
+		}
+		rewards = rewards.Add(coin)
+	}
+	holder.Pending = remainder
+	err := k.setDistributionHolder(ctx, denom, holder)
+	if err != nil {
+		return nil, err
+	}
+
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	k.trackBeforeSend(ctx, nil, addr, rewards)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, rewards)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+// deleteDistribution removes the reward distribution state of a denom. It fails if a
+// holder still has rewards to claim. The decimal remainders of the rewards are kept by the
+// module account.
+func (k Keeper) deleteDistribution(ctx sdk.Context, denom string) error {
+	holders := k.GetDistributionHolders(ctx, denom)
+	for _, holder := range holders {
+		rewards, _ := holder.Pending.TruncateDecimal()
+		if !rewards.IsZero() {
+			return types.ErrUnclaimedDistribution.Wrapf("holder %s has %s to claim", holder.Address, rewards)
+		}
+	}
+
+	denomStore := k.GetDenomPrefixStore(ctx, denom)
+	denomStore.Delete(types.DenomDistributionKey)
+	for _, holder := range holders {
+		denomStore.Delete(types.GetDistributionHolderKey(sdk.MustAccAddressFromBech32(holder.Address)))
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestDepositDistribution() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 400))
+	s.FundAcc(admin, rewards)
+
+	// only the admin can deposit distributions
+	_, err := s.msgServer.DepositDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgDepositDistribution(s.TestAccs[1].String(), s.defaultDenom, rewards))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// a denom without holders can't get distributions
+	_, err = s.msgServer.DepositDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, rewards))
+	s.Require().ErrorIs(err, types.ErrInvalidDistribution)
+
+	// the tokens held in escrow by the module account don't take part in distributions
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MintVesting(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintVesting(admin.String(), s.TestAccs[1].String(), sdk.NewInt64Coin(s.defaultDenom, 100), s.Ctx.BlockTime(), s.Ctx.BlockTime(), s.Ctx.BlockTime().Add(1000)))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.DepositDistribution(sdk.WrapSDKContext(ctx), types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, rewards))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventDepositDistribution{}), 1)
+
+	distribution, found := s.App.TokenfactoryKeeper.GetDistribution(s.Ctx, s.defaultDenom)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 4)), distribution.RewardPerToken)
+	s.Require().Equal(rewards, s.App.TokenfactoryKeeper.GetPendingRewards(s.Ctx, s.defaultDenom, admin))
+}
+
+func (s *KeeperTestSuite) TestClaimDistribution() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+	hooks := s.App.TokenfactoryKeeper.Hooks()
+	s.FundAcc(admin, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600)))
+
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 300), holder.String()))
+	s.Require().NoError(err)
+
+	// one reward per token
+	_, err = s.msgServer.DepositDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 400))))
+	s.Require().NoError(err)
+
+	// the rewards accrued before a transfer are kept by the sender
+	sent := sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 100))
+	hooks.TrackBeforeSend(s.Ctx, holder, admin, sent)
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, holder, admin, sent))
+
+	// half a reward per token
+	_, err = s.msgServer.DepositDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 200))))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		addr     sdk.AccAddress
+		expected int64
+	}{
+		{admin, 100 + 100},
+		{holder, 300 + 100},
+	} {
+		res, err := s.queryClient.PendingRewards(s.Ctx.Context(), &types.QueryPendingRewardsRequest{Denom: s.defaultDenom, Address: tc.addr.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", tc.expected)), res.Rewards)
+	}
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := s.msgServer.ClaimDistribution(sdk.WrapSDKContext(ctx), types.NewMsgClaimDistribution(holder.String(), s.defaultDenom))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventClaimDistribution{}), 1)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 400)), res.Amount)
+	s.Require().Equal(int64(400), s.App.BankKeeper.GetBalance(s.Ctx, holder, "uosmo").Amount.Int64())
+	s.Require().True(s.App.TokenfactoryKeeper.GetPendingRewards(s.Ctx, s.defaultDenom, holder).IsZero())
+
+	// a denom can't be deleted while its holders have rewards to claim
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 200)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 200), holder.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DeleteDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgDeleteDenom(admin.String(), s.defaultDenom, false))
+	s.Require().ErrorIs(err, types.ErrUnclaimedDistribution)
+
+	_, err = s.msgServer.ClaimDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgClaimDistribution(admin.String(), s.defaultDenom))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DeleteDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgDeleteDenom(admin.String(), s.defaultDenom, false))
+	s.Require().NoError(err)
+	_, found := s.App.TokenfactoryKeeper.GetDistribution(s.Ctx, s.defaultDenom)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestDistributionUntrackedHolder() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+	s.FundAcc(admin, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 300)))
+
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	// one reward per token
+	_, err = s.msgServer.DepositDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100))))
+	s.Require().NoError(err)
+
+	// a balance received without the send hooks doesn't accrue the rewards distributed before
+	// the holder is first seen
+	s.FundAcc(holder, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().True(s.App.TokenfactoryKeeper.GetPendingRewards(s.Ctx, s.defaultDenom, holder).IsZero())
+
+	res, err := s.msgServer.ClaimDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgClaimDistribution(holder.String(), s.defaultDenom))
+	s.Require().NoError(err)
+	s.Require().True(res.Amount.IsZero())
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 1)), s.App.TokenfactoryKeeper.GetDistributionHolder(s.Ctx, s.defaultDenom, holder).RewardPerToken)
+
+	// but accrues the rewards distributed afterwards, one reward per token
+	_, err = s.msgServer.DepositDistribution(sdk.WrapSDKContext(s.Ctx), types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 200))))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)), s.App.TokenfactoryKeeper.GetPendingRewards(s.Ctx, s.defaultDenom, holder))
+
+	// while a holder seen before the first distribution accrues all of them
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 200)), s.App.TokenfactoryKeeper.GetPendingRewards(s.Ctx, s.defaultDenom, admin))
+}
+
+// TestDistributionRestrictedRewards tests that rewards of a restricted denom can only be
+// claimed by the holders on its allowlist, and that rewards with a transfer fee are rejected
+func (s *KeeperTestSuite) TestDistributionRestrictedRewards() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	res, err := s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(admin.String(), "reward"))
+	s.Require().NoError(err)
+	rewardDenom := res.GetNewTokenDenom()
+
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), holder.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(rewardDenom, 400)))
+	s.Require().NoError(err)
+
+	// a reward denom with a transfer fee can't be distributed
+	_, err = s.msgServer.SetTransferFee(goCtx, types.NewMsgSetTransferFee(admin.String(), rewardDenom, types.TransferFee{BasisPoints: 100, Recipient: admin.String()}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DepositDistribution(goCtx, types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 200))))
+	s.Require().ErrorIs(err, types.ErrInvalidDistribution)
+	_, err = s.msgServer.SetTransferFee(goCtx, types.NewMsgSetTransferFee(admin.String(), rewardDenom, types.TransferFee{}))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetRestricted(goCtx, types.NewMsgSetRestricted(admin.String(), rewardDenom, true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddToAllowlist(goCtx, types.NewMsgAddToAllowlist(admin.String(), rewardDenom, []string{admin.String()}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DepositDistribution(goCtx, types.NewMsgDepositDistribution(admin.String(), s.defaultDenom, sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 200))))
+	s.Require().NoError(err)
+
+	// the holder isn't on the allowlist, so its rewards stay pending
+	claimRes, err := s.msgServer.ClaimDistribution(goCtx, types.NewMsgClaimDistribution(holder.String(), s.defaultDenom))
+	s.Require().NoError(err)
+	s.Require().True(claimRes.Amount.IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, holder, rewardDenom).IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 100)), s.App.TokenfactoryKeeper.GetPendingRewards(s.Ctx, s.defaultDenom, holder))
+
+	claimRes, err = s.msgServer.ClaimDistribution(goCtx, types.NewMsgClaimDistribution(admin.String(), s.defaultDenom))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 100)), claimRes.Amount)
+
+	_, err = s.msgServer.AddToAllowlist(goCtx, types.NewMsgAddToAllowlist(admin.String(), rewardDenom, []string{holder.String()}))
+	s.Require().NoError(err)
+	claimRes, err = s.msgServer.ClaimDistribution(goCtx, types.NewMsgClaimDistribution(holder.String(), s.defaultDenom))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 100)), claimRes.Amount)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/tokenfactory/types"
@@ -21,7 +23,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
-		err = k.createDenomAfterValidation(ctx, creator, genDenom.GetDenom())
+		err = k.createDenomAfterValidation(ctx, creator, genDenom.GetDenom(), sdk.NewCoins())
 		if err != nil {
 			panic(err)
 		}
@@ -29,7 +31,121 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if genDenom.CreationRecord != nil {
+			err = k.setDenomCreationRecord(ctx, genDenom.GetDenom(), *genDenom.CreationRecord)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.Deposit != nil {
+			err = k.setDenomDeposit(ctx, genDenom.GetDenom(), *genDenom.Deposit)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.TokenProfile != nil {
+			err = k.setTokenProfile(ctx, genDenom.GetDenom(), *genDenom.TokenProfile)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, snapshot := range genDenom.GetSnapshots() {
+			err = k.setSnapshot(ctx, genDenom.GetDenom(), snapshot)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, checkpoint := range genDenom.GetSnapshotCheckpoints() {
+			err = k.setSnapshotCheckpoint(ctx, genDenom.GetDenom(), sdk.MustAccAddressFromBech32(checkpoint.Address), checkpoint.SnapshotID, checkpoint.Balance)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, holder := range genDenom.GetSnapshotHolders() {
+			k.setSnapshotHolder(ctx, genDenom.GetDenom(), sdk.MustAccAddressFromBech32(holder))
+		}
+		if genDenom.Distribution != nil {
+			err = k.setDistribution(ctx, genDenom.GetDenom(), *genDenom.Distribution)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, holder := range genDenom.GetDistributionHolders() {
+			err = k.setDistributionHolder(ctx, genDenom.GetDenom(), holder)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.Backing != nil {
+			err = k.setDenomBacking(ctx, genDenom.GetDenom(), *genDenom.Backing)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.TransferFee != nil {
+			err = k.setTransferFee(ctx, genDenom.GetDenom(), *genDenom.TransferFee)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.MaxBalance != nil {
+			err = k.setMaxBalance(ctx, genDenom.GetDenom(), *genDenom.MaxBalance)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, allowance := range genDenom.Allowances {
+			err = k.setAllowance(ctx, allowance)
+			if err != nil {
+				panic(err)
+			}
+		}
+		k.setRestricted(ctx, genDenom.GetDenom(), genDenom.Restricted)
+		for _, addr := range genDenom.Allowlist {
+			k.addToAllowlist(ctx, genDenom.GetDenom(), sdk.MustAccAddressFromBech32(addr))
+		}
+		if genDenom.HolderIndexEnabled {
+			err = k.enableHolderIndex(ctx, genDenom.GetDenom())
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, denom := range genState.GetTombstonedDenoms() {
+		k.setDenomTombstone(ctx, denom)
+	}
+
+	for _, schedule := range genState.GetVestingSchedules() {
+		err := k.setVestingSchedule(ctx, schedule)
+		if err != nil {
+			panic(err)
+		}
+	}
+	k.setNextVestingScheduleID(ctx, genState.GetNextVestingScheduleID())
+
+	for _, schedule := range genState.GetMintSchedules() {
+		err := k.setMintSchedule(ctx, schedule)
+		if err != nil {
+			panic(err)
+		}
 	}
+	k.setNextMintScheduleID(ctx, genState.GetNextMintScheduleID())
+
+	for _, route := range genState.GetConversionRoutes() {
+		err := k.setConversionRoute(ctx, route)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, window := range genState.GetCreationWindows() {
+		err := k.setCreatorCreationWindow(ctx, sdk.MustAccAddressFromBech32(window.Creator), window)
+		if err != nil {
+			panic(err)
+		}
+	}
+	k.setMintScheduleCursor(ctx, genState.GetMintScheduleCursor())
 }
 
 // ExportGenesis returns the tokenfactory module's exported genesis.
@@ -45,14 +161,68 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		creationRecord, found := k.GetDenomCreationRecord(ctx, denom)
+		if !found {
+			panic(fmt.Sprintf("denom %s has no creation record", denom))
+		}
+
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+			CreationRecord:    &creationRecord,
+		}
+		if deposit, found := k.GetDenomDeposit(ctx, denom); found {
+			genDenom.Deposit = &deposit
+		}
+		if profile, found := k.GetTokenProfile(ctx, denom); found {
+			genDenom.TokenProfile = &profile
+		}
+		if snapshots := k.GetSnapshots(ctx, denom); len(snapshots) > 0 {
+			genDenom.Snapshots = snapshots
+		}
+		if checkpoints := k.GetSnapshotCheckpoints(ctx, denom); len(checkpoints) > 0 {
+			genDenom.SnapshotCheckpoints = checkpoints
+		}
+		if holders := k.GetSnapshotHolders(ctx, denom); len(holders) > 0 {
+			genDenom.SnapshotHolders = holders
+		}
+		if distribution, found := k.GetDistribution(ctx, denom); found {
+			genDenom.Distribution = &distribution
+		}
+		if holders := k.GetDistributionHolders(ctx, denom); len(holders) > 0 {
+			genDenom.DistributionHolders = holders
+		}
+		genDenom.HolderIndexEnabled = k.IsHolderIndexEnabled(ctx, denom)
+		if backing, found := k.GetDenomBacking(ctx, denom); found {
+			genDenom.Backing = &backing
+		}
+		if fee, found := k.GetTransferFee(ctx, denom); found {
+			genDenom.TransferFee = &fee
+		}
+		if maxBalance, found := k.GetMaxBalance(ctx, denom); found {
+			genDenom.MaxBalance = &maxBalance
+		}
+		if allowances := k.GetAllowances(ctx, denom); len(allowances) > 0 {
+			genDenom.Allowances = allowances
+		}
+		genDenom.Restricted = k.IsRestricted(ctx, denom)
+		if allowlist := k.GetAllowlist(ctx, denom); len(allowlist) > 0 {
+			genDenom.Allowlist = allowlist
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
-		FactoryDenoms: genDenoms,
-		Params:        k.GetParams(ctx),
+		FactoryDenoms:         genDenoms,
+		Params:                k.GetParams(ctx),
+		TombstonedDenoms:      k.GetTombstonedDenoms(ctx),
+		VestingSchedules:      k.GetAllVestingSchedules(ctx),
+		NextVestingScheduleID: k.GetNextVestingScheduleID(ctx),
+		MintSchedules:         k.GetAllMintSchedules(ctx),
+		NextMintScheduleID:    k.GetNextMintScheduleID(ctx),
+		ConversionRoutes:      k.GetAllConversionRoutes(ctx),
+		CreationWindows:       k.GetAllCreatorCreationWindows(ctx),
+		MintScheduleCursor:    k.getMintScheduleCursor(ctx),
 	}
 }
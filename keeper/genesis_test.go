@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -16,20 +18,125 @@ func (s *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
 				},
+				CreationRecord: &types.DenomCreationRecord{
+					Creator:        "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+					CreationHeight: 10,
+					CreationTime:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					CreationFee:    sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)),
+				},
+				Deposit: &types.DenomDeposit{
+					Depositor: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+					Amount:    sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)),
+				},
+				TransferFee: &types.TransferFee{
+					BasisPoints:     50,
+					Recipient:       "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+					ExemptAddresses: []string{"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+				},
+				Allowances: []types.Allowance{
+					{
+						Owner:   "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+						Spender: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh",
+						Amount:  sdk.NewInt64Coin("factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/bitcoin", 500),
+					},
+				},
 			},
 			{
 				Denom: "factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/diff-admin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
 				},
+				Backing: &types.DenomBacking{
+					CollateralDenom: "uatom",
+					Escrowed:        sdk.ZeroInt(),
+				},
 			},
 			{
 				Denom: "factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/litecoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
 				},
+				CreationRecord: &types.DenomCreationRecord{
+					Creator:        "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+					CreationHeight: 20,
+					CreationTime:   time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				},
+				TokenProfile: &types.TokenProfile{
+					Website: "https://litecoin.org",
+					Tags:    []string{"payments"},
+				},
+				Snapshots: []types.Snapshot{
+					{ID: 1, Height: 30, Time: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)},
+				},
+				SnapshotCheckpoints: []types.SnapshotCheckpoint{
+					{Address: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79", SnapshotID: 1, Balance: sdk.NewInt(100)},
+				},
+				SnapshotHolders: []string{"cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79"},
+				Distribution: &types.Distribution{
+					RewardPerToken: sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 2)),
+				},
+				DistributionHolders: []types.DistributionHolder{
+					{
+						Address:        "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+						RewardPerToken: sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 1)),
+						Pending:        sdk.NewDecCoins(sdk.NewDecCoinFromDec("uosmo", sdk.NewDecWithPrec(15, 1))),
+					},
+				},
+				HolderIndexEnabled: true,
+				Restricted:         true,
+				MaxBalance: &types.MaxBalance{
+					Amount:          sdk.NewInt(1000000),
+					ExemptAddresses: []string{"cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79"},
+				},
+				Allowlist: []string{"cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79"},
+			},
+		},
+		Params: types.Params{
+			ReservedSubdenoms:              []string{"atom", "usd*"},
+			ReservedSubdenomExemptCreators: []string{"cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79"},
+		},
+		TombstonedDenoms: []string{"factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/deleted"},
+		VestingSchedules: []types.VestingSchedule{
+			{
+				ID:        3,
+				Recipient: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+				Amount:    sdk.NewInt64Coin("factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/bitcoin", 1000),
+				Claimed:   sdk.NewInt(100),
+				StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				CliffTime: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		NextVestingScheduleID: 4,
+		MintSchedules: []types.MintSchedule{
+			{
+				ID:             1,
+				Creator:        "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+				Recipient:      "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+				Amount:         sdk.NewInt64Coin("factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/litecoin", 500),
+				PeriodSeconds:  86400,
+				NextTime:       time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+				RemainingMints: 10,
+				DecayRate:      sdk.NewDecWithPrec(1, 1),
+			},
+		},
+		NextMintScheduleID: 2,
+		ConversionRoutes: []types.ConversionRoute{
+			{
+				Creator:     "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+				SourceDenom: "factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/bitcoin",
+				TargetDenom: "factory/cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79/litecoin",
+				Ratio:       sdk.NewDec(2),
 			},
 		},
+		CreationWindows: []types.CreatorCreationWindow{
+			{
+				WindowStartHeight: 5,
+				Creations:         2,
+				Creator:           "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+			},
+		},
+		MintScheduleCursor: 1,
 	}
 
 	s.SetupTestForInitGenesis()
@@ -54,6 +161,15 @@ func (s *KeeperTestSuite) TestGenesis() {
 	tokenfactoryModuleAccount = app.AccountKeeper.GetAccount(s.Ctx, app.AccountKeeper.GetModuleAddress(types.ModuleName))
 	s.Require().NotNil(tokenfactoryModuleAccount)
 
+	// denoms without a creation record in genesis get one at genesis
+	_, found := app.TokenfactoryKeeper.GetDenomCreationRecord(s.Ctx, genesisState.FactoryDenoms[1].Denom)
+	s.Require().True(found)
+	genesisState.FactoryDenoms[1].CreationRecord = &types.DenomCreationRecord{
+		Creator:        "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79",
+		CreationHeight: s.Ctx.BlockHeight(),
+		CreationTime:   s.Ctx.BlockTime(),
+	}
+
 	exportedGenesis := app.TokenfactoryKeeper.ExportGenesis(s.Ctx)
 	s.Require().NotNil(exportedGenesis)
 	s.Require().Equal(genesisState, *exportedGenesis)
@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/osmosis-labs/tokenfactory/types"
 )
@@ -30,6 +36,327 @@ func (k Keeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDeno
 
 func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	denoms := k.getDenomsFromCreator(sdkCtx, req.GetCreator())
+
+	creator, err := sdk.AccAddressFromBech32(req.GetCreator())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denoms := k.getDenomsFromCreator(sdkCtx, creator)
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) DenomCreationRecord(ctx context.Context, req *types.QueryDenomCreationRecordRequest) (*types.QueryDenomCreationRecordResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creationRecord, found := k.GetDenomCreationRecord(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", req.GetDenom())
+	}
+
+	return &types.QueryDenomCreationRecordResponse{CreationRecord: creationRecord}, nil
+}
+
+func (k Keeper) SubdenomAvailability(ctx context.Context, req *types.QuerySubdenomAvailabilityRequest) (*types.QuerySubdenomAvailabilityResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := sdk.AccAddressFromBech32(req.GetCreator()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denom, err := k.validateCreateDenom(sdkCtx, k.GetParams(sdkCtx), req.GetCreator(), req.GetSubdenom())
+	if err != nil {
+		return &types.QuerySubdenomAvailabilityResponse{Available: false, Reason: err.Error()}, nil
+	}
+
+	return &types.QuerySubdenomAvailabilityResponse{Denom: denom, Available: true}, nil
+}
+
+func (k Keeper) ReservedSubdenoms(ctx context.Context, req *types.QueryReservedSubdenomsRequest) (*types.QueryReservedSubdenomsResponse, error) {
+	params := k.GetParams(sdk.UnwrapSDKContext(ctx))
+
+	return &types.QueryReservedSubdenomsResponse{
+		Patterns:       params.ReservedSubdenoms,
+		ExemptCreators: params.ReservedSubdenomExemptCreators,
+	}, nil
+}
+
+func (k Keeper) DenomDeposit(ctx context.Context, req *types.QueryDenomDepositRequest) (*types.QueryDenomDepositResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	deposit, found := k.GetDenomDeposit(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s has no deposit", req.GetDenom())
+	}
+
+	return &types.QueryDenomDepositResponse{Deposit: deposit}, nil
+}
+
+func (k Keeper) TokenProfile(ctx context.Context, req *types.QueryTokenProfileRequest) (*types.QueryTokenProfileResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	profile, found := k.GetTokenProfile(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s has no token profile", req.GetDenom())
+	}
+
+	return &types.QueryTokenProfileResponse{Profile: profile}, nil
+}
+
+func (k Keeper) VestingSchedules(ctx context.Context, req *types.QueryVestingSchedulesRequest) (*types.QueryVestingSchedulesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	recipient, err := sdk.AccAddressFromBech32(req.GetRecipient())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &types.QueryVestingSchedulesResponse{
+		Schedules: k.GetVestingSchedules(sdkCtx, recipient),
+		Vested:    sdk.NewCoins(),
+		Unvested:  sdk.NewCoins(),
+		Claimable: sdk.NewCoins(),
+	}
+	for _, schedule := range res.Schedules {
+		vested := schedule.VestedAmount(sdkCtx.BlockTime())
+		res.Vested = res.Vested.Add(sdk.NewCoin(schedule.Amount.Denom, vested))
+		res.Unvested = res.Unvested.Add(sdk.NewCoin(schedule.Amount.Denom, schedule.Amount.Amount.Sub(vested)))
+		res.Claimable = res.Claimable.Add(sdk.NewCoin(schedule.Amount.Denom, vested.Sub(schedule.Claimed)))
+	}
+
+	return res, nil
+}
+
+func (k Keeper) MintSchedules(ctx context.Context, req *types.QueryMintSchedulesRequest) (*types.QueryMintSchedulesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	schedules := []types.MintSchedule{}
+	var pageRes *query.PageResponse
+	var err error
+	if req.GetDenom() == "" {
+		store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.MintSchedulePrefixKey)
+		pageRes, err = query.Paginate(store, req.GetPagination(), func(_, value []byte) error {
+			schedule := types.MintSchedule{}
+			if err := proto.Unmarshal(value, &schedule); err != nil {
+				return err
+			}
+			schedules = append(schedules, schedule)
+			return nil
+		})
+	} else {
+		store := prefix.NewStore(k.GetDenomPrefixStore(sdkCtx, req.GetDenom()), types.DenomMintSchedulePrefixKey)
+		pageRes, err = query.Paginate(store, req.GetPagination(), func(key, _ []byte) error {
+			schedule, found := k.GetMintSchedule(sdkCtx, sdk.BigEndianToUint64(key))
+			if !found {
+				return fmt.Errorf("mint schedule %d not found", sdk.BigEndianToUint64(key))
+			}
+			schedules = append(schedules, schedule)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BalanceAtSnapshot(ctx context.Context, req *types.QueryBalanceAtSnapshotRequest) (*types.QueryBalanceAtSnapshotResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addr, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	balance, err := k.GetBalanceAtSnapshot(sdkCtx, req.GetDenom(), addr, req.GetSnapshotId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBalanceAtSnapshotResponse{Balance: sdk.NewCoin(req.GetDenom(), balance)}, nil
+}
+
+func (k Keeper) SnapshotHolders(ctx context.Context, req *types.QuerySnapshotHoldersRequest) (*types.QuerySnapshotHoldersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	snapshot, found := k.GetSnapshot(sdkCtx, req.GetDenom(), req.GetSnapshotId())
+	if !found {
+		return nil, types.ErrSnapshotNotFound.Wrapf("denom: %s, id: %d", req.GetDenom(), req.GetSnapshotId())
+	}
+
+	holders := []types.SnapshotBalance{}
+	store := prefix.NewStore(k.GetDenomPrefixStore(sdkCtx, req.GetDenom()), types.DenomSnapshotHolderPrefixKey)
+	pageRes, err := query.FilteredPaginate(store, req.GetPagination(), func(key, _ []byte, accumulate bool) (bool, error) {
+		addr := sdk.AccAddress(key)
+		balance, err := k.GetBalanceAtSnapshot(sdkCtx, req.GetDenom(), addr, snapshot.ID)
+		if err != nil {
+			return false, err
+		}
+		if !balance.IsPositive() {
+			return false, nil
+		}
+
+		if accumulate {
+			holders = append(holders, types.SnapshotBalance{Address: addr.String(), Balance: balance})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySnapshotHoldersResponse{Snapshot: snapshot, Holders: holders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingRewards(ctx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addr, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPendingRewardsResponse{Rewards: k.GetPendingRewards(sdkCtx, req.GetDenom(), addr)}, nil
+}
+
+func (k Keeper) HolderCount(ctx context.Context, req *types.QueryHolderCountRequest) (*types.QueryHolderCountResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	count, err := k.GetHolderCount(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHolderCountResponse{Count: count}, nil
+}
+
+func (k Keeper) TopHolders(ctx context.Context, req *types.QueryTopHoldersRequest) (*types.QueryTopHoldersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !k.IsHolderIndexEnabled(sdkCtx, req.GetDenom()) {
+		return nil, types.ErrHolderIndexDisabled.Wrapf("denom: %s", req.GetDenom())
+	}
+
+	holders := []types.DenomHolder{}
+	store := prefix.NewStore(k.GetDenomPrefixStore(sdkCtx, req.GetDenom()), types.DenomHolderByBalancePrefixKey)
+	pageRes, err := query.Paginate(store, req.GetPagination(), func(key, _ []byte) error {
+		balance, addr := types.ParseHolderByBalanceKey(key)
+		holders = append(holders, types.DenomHolder{Address: addr.String(), Balance: balance})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ConversionRoutes(ctx context.Context, req *types.QueryConversionRoutesRequest) (*types.QueryConversionRoutesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryConversionRoutesResponse{Routes: k.GetActiveConversionRoutes(sdkCtx)}, nil
+}
+
+func (k Keeper) DenomBacking(ctx context.Context, req *types.QueryDenomBackingRequest) (*types.QueryDenomBackingResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	backing, found := k.GetDenomBacking(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s is not backed", req.GetDenom())
+	}
+
+	return &types.QueryDenomBackingResponse{Backing: backing}, nil
+}
+
+func (k Keeper) TransferFee(ctx context.Context, req *types.QueryTransferFeeRequest) (*types.QueryTransferFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	fee, found := k.GetTransferFee(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s has no transfer fee", req.GetDenom())
+	}
+
+	return &types.QueryTransferFeeResponse{Fee: fee}, nil
+}
+
+func (k Keeper) Allowlist(ctx context.Context, req *types.QueryAllowlistRequest) (*types.QueryAllowlistResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addresses := []string{}
+	store := prefix.NewStore(k.GetDenomPrefixStore(sdkCtx, req.GetDenom()), types.DenomAllowlistPrefixKey)
+	pageRes, err := query.Paginate(store, req.GetPagination(), func(key, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowlistResponse{
+		Restricted: k.IsRestricted(sdkCtx, req.GetDenom()),
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) MaxBalance(ctx context.Context, req *types.QueryMaxBalanceRequest) (*types.QueryMaxBalanceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	maxBalance, found := k.GetMaxBalance(sdkCtx, req.GetDenom())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s has no max balance", req.GetDenom())
+	}
+
+	return &types.QueryMaxBalanceResponse{MaxBalance: maxBalance}, nil
+}
+
+func (k Keeper) Allowance(ctx context.Context, req *types.QueryAllowanceRequest) (*types.QueryAllowanceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.GetOwner())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	spender, err := sdk.AccAddressFromBech32(req.GetSpender())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	allowance, found := k.GetAllowance(sdkCtx, req.GetDenom(), owner, spender)
+	if !found || allowance.IsExpired(sdkCtx.BlockTime()) {
+		return nil, status.Errorf(codes.NotFound, "%s has no allowance for the %s of %s", spender, req.GetDenom(), owner)
+	}
+
+	return &types.QueryAllowanceResponse{Allowance: allowance}, nil
+}
+
+func (k Keeper) Allowances(ctx context.Context, req *types.QueryAllowancesRequest) (*types.QueryAllowancesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.GetOwner())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	allowances := []types.Allowance{}
+	store := prefix.NewStore(k.GetDenomPrefixStore(sdkCtx, req.GetDenom()), types.GetAllowancesPrefix(owner))
+	pageRes, err := query.FilteredPaginate(store, req.GetPagination(), func(_, value []byte, accumulate bool) (bool, error) {
+		allowance := types.Allowance{}
+		if err := proto.Unmarshal(value, &allowance); err != nil {
+			return false, err
+		}
+		if allowance.IsExpired(sdkCtx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			allowances = append(allowances, allowance)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowancesResponse{Allowances: allowances, Pagination: pageRes}, nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// IsHolderIndexEnabled returns whether a denom has a holder index
+func (k Keeper) IsHolderIndexEnabled(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.DenomHolderCountKey)
+}
+
+// GetHolderCount returns the number of accounts with a positive balance in the holder
+// index of a denom
+func (k Keeper) GetHolderCount(ctx sdk.Context, denom string) (uint64, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomHolderCountKey)
+	if bz == nil {
+		return 0, types.ErrHolderIndexDisabled.Wrapf("denom: %s", denom)
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+func (k Keeper) setHolderCount(ctx sdk.Context, denom string, count uint64) {
+	k.GetDenomPrefixStore(ctx, denom).Set(types.DenomHolderCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// GetTopHolders returns the holders in the holder index of a denom, sorted by descending
+// balance
+func (k Keeper) GetTopHolders(ctx sdk.Context, denom string) []types.DenomHolder {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomHolderByBalancePrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	holders := []types.DenomHolder{}
+	for ; iterator.Valid(); iterator.Next() {
+		balance, addr := types.ParseHolderByBalanceKey(iterator.Key())
+		holders = append(holders, types.DenomHolder{Address: addr.String(), Balance: balance})
+	}
+	return holders
+}
+
+// setHolderBalance updates the balance of addr in the holder index of a denom. Accounts
+// with a zero balance are removed from the index.
+func (k Keeper) setHolderBalance(ctx sdk.Context, denom string, addr sdk.AccAddress, balance sdk.Int) error {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	count, err := k.GetHolderCount(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if bz := store.Get(types.GetHolderBalanceKey(addr)); bz != nil {
+		previous := sdk.Int{}
+		if err := previous.Unmarshal(bz); err != nil {
+			return err
+		}
+		store.Delete(types.GetHolderByBalanceKey(previous, addr))
+		store.Delete(types.GetHolderBalanceKey(addr))
+		count--
+	}
+
+	if balance.IsPositive() {
+		bz, err := balance.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetHolderBalanceKey(addr), bz)
+		store.Set(types.GetHolderByBalanceKey(balance, addr), []byte{})
+		count++
+	}
+
+	k.setHolderCount(ctx, denom, count)
+	return nil
+}
+
+// trackHolderBalance updates the balance of addr in the holder index of a denom, if the
+// denom has one, with the change of its balance by delta. It must be called before the
+// balance changes.
+func (k Keeper) trackHolderBalance(ctx sdk.Context, denom string, addr sdk.AccAddress, delta sdk.Int) {
+	if !k.IsHolderIndexEnabled(ctx, denom) {
+		return
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom).Amount.Add(delta)
+	if balance.IsNegative() {
+		// the balance change fails
+		return
+	}
+
+	err := k.setHolderBalance(ctx, denom, addr, balance)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// enableHolderIndex builds the holder index of a denom from the balances of the accounts
+// that received the denom. The tokens held in escrow by the module account are not
+// indexed.
+func (k Keeper) enableHolderIndex(ctx sdk.Context, denom string) error {
+	if k.IsHolderIndexEnabled(ctx, denom) {
+		return nil
+	}
+
+	k.setHolderCount(ctx, denom, 0)
+	for _, holder := range k.GetSnapshotHolders(ctx, denom) {
+		addr := sdk.MustAccAddressFromBech32(holder)
+		err := k.setHolderBalance(ctx, denom, addr, k.bankKeeper.GetBalance(ctx, addr, denom).Amount)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// disableHolderIndex removes the holder index of a denom
+func (k Keeper) disableHolderIndex(ctx sdk.Context, denom string) {
+	denomStore := k.GetDenomPrefixStore(ctx, denom)
+	for _, prefixKey := range [][]byte{types.DenomHolderBalancePrefixKey, types.DenomHolderByBalancePrefixKey} {
+		store := prefix.NewStore(denomStore, prefixKey)
+		iterator := store.Iterator(nil, nil)
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	denomStore.Delete(types.DenomHolderCountKey)
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSetHolderIndex() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+
+	// balances before the index is enabled are indexed when it is enabled
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), holder.String()))
+	s.Require().NoError(err)
+
+	_, err = s.queryClient.HolderCount(s.Ctx.Context(), &types.QueryHolderCountRequest{Denom: s.defaultDenom})
+	s.Require().ErrorContains(err, types.ErrHolderIndexDisabled.Error())
+
+	// only the admin can enable the holder index
+	_, err = s.msgServer.SetHolderIndex(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetHolderIndex(holder.String(), s.defaultDenom, true))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetHolderIndex(sdk.WrapSDKContext(ctx), types.NewMsgSetHolderIndex(admin.String(), s.defaultDenom, true))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetHolderIndex{}), 1)
+
+	countRes, err := s.queryClient.HolderCount(s.Ctx.Context(), &types.QueryHolderCountRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), countRes.Count)
+
+	// disabling the index removes it
+	_, err = s.msgServer.SetHolderIndex(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetHolderIndex(admin.String(), s.defaultDenom, false))
+	s.Require().NoError(err)
+	s.Require().False(s.App.TokenfactoryKeeper.IsHolderIndexEnabled(s.Ctx, s.defaultDenom))
+	_, err = s.queryClient.TopHolders(s.Ctx.Context(), &types.QueryTopHoldersRequest{Denom: s.defaultDenom})
+	s.Require().ErrorContains(err, types.ErrHolderIndexDisabled.Error())
+}
+
+func (s *KeeperTestSuite) TestTopHolders() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0]
+	hooks := s.App.TokenfactoryKeeper.Hooks()
+
+	_, err := s.msgServer.SetHolderIndex(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetHolderIndex(admin.String(), s.defaultDenom, true))
+	s.Require().NoError(err)
+
+	// the index is updated by mints, sends and burns
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 300)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100), s.TestAccs[1].String()))
+	s.Require().NoError(err)
+
+	sent := sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 200))
+	hooks.TrackBeforeSend(s.Ctx, admin, s.TestAccs[2], sent)
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, admin, s.TestAccs[2], sent))
+
+	// a send to the sender itself doesn't change the index
+	sent = sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 50))
+	hooks.TrackBeforeSend(s.Ctx, s.TestAccs[2], s.TestAccs[2], sent)
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, s.TestAccs[2], s.TestAccs[2], sent))
+
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	countRes, err := s.queryClient.HolderCount(s.Ctx.Context(), &types.QueryHolderCountRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), countRes.Count)
+
+	holdersRes, err := s.queryClient.TopHolders(s.Ctx.Context(), &types.QueryTopHoldersRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomHolder{
+		{Address: s.TestAccs[2].String(), Balance: sdk.NewInt(200)},
+		{Address: s.TestAccs[1].String(), Balance: sdk.NewInt(100)},
+	}, holdersRes.Holders)
+
+	holdersRes, err = s.queryClient.TopHolders(s.Ctx.Context(), &types.QueryTopHoldersRequest{
+		Denom:      s.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomHolder{{Address: s.TestAccs[2].String(), Balance: sdk.NewInt(200)}}, holdersRes.Holders)
+	s.Require().NotNil(holdersRes.Pagination.NextKey)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// RegisterInvariants registers the tokenfactory module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "backed-supply", BackedSupplyInvariant(k))
+}
+
+// BackedSupplyInvariant checks that the supply of every backed denom equals its escrowed
+// collateral, and that the module account holds the escrowed collateral
+func BackedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		escrowed := sdk.NewCoins()
+		iterator := k.GetAllDenomsIterator(ctx)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			denom := string(iterator.Value())
+			backing, found := k.GetDenomBacking(ctx, denom)
+			if !found {
+				continue
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, denom)
+			if !supply.Amount.Equal(backing.Escrowed) {
+				broken = true
+				msg += fmt.Sprintf("\tsupply of %s is %s but its escrowed collateral is %s%s\n", denom, supply.Amount, backing.Escrowed, backing.CollateralDenom)
+			}
+			escrowed = escrowed.Add(sdk.NewCoin(backing.CollateralDenom, backing.Escrowed))
+		}
+
+		moduleAddr := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+		for _, collateral := range escrowed {
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, collateral.Denom)
+			if balance.IsLT(collateral) {
+				broken = true
+				msg += fmt.Sprintf("\tmodule account holds %s but %s is escrowed\n", balance, collateral)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "backed-supply", msg), broken
+	}
+}
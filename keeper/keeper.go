@@ -24,6 +24,16 @@ type (
 		contractKeeper types.ContractKeeper
 
 		distrKeeper types.DistrKeeper
+
+		// whether the app registered the send hooks of the module with a bank
+		// keeper that supports them. The features enforced in the hooks can only
+		// be enabled when they are registered.
+		sendHooksRegistered bool
+
+		// the address capable of executing authority messages, such as
+		// MsgUpdateReservedSubdenoms. Typically, this should be the x/gov
+		// module account.
+		authority string
 	}
 )
 
@@ -34,6 +44,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
+	authority string,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -46,9 +57,16 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
+
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the address capable of executing authority messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -61,7 +79,7 @@ func (k Keeper) GetDenomPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
 }
 
 // GetCreatorPrefixStore returns the substore for a specific creator address
-func (k Keeper) GetCreatorPrefixStore(ctx sdk.Context, creator string) sdk.KVStore {
+func (k Keeper) GetCreatorPrefixStore(ctx sdk.Context, creator sdk.AccAddress) sdk.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.GetCreatorPrefix(creator))
 }
@@ -77,6 +95,12 @@ func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
 	k.contractKeeper = contractKeeper
 }
 
+// SetSendHooksRegistered records that the app registered Hooks with its bank keeper. It must
+// be called by apps whose bank module supports before-send hooks, after registering them.
+func (k *Keeper) SetSendHooksRegistered() {
+	k.sendHooksRegistered = true
+}
+
 // CreateModuleAccount creates a module account with minting and burning capabilities
 // This account isn't intended to store any coins,
 // it purely mints and burns them on behalf of the admin of respective denoms,
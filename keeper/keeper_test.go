@@ -79,7 +79,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.TestAccs = append(s.TestAccs, baseTestAccts...)
 
 	s.queryClient = types.NewQueryClient(s.QueryHelper)
-	s.msgServer = keeper.NewMsgServerImpl(s.App.TokenfactoryKeeper)
+	// the bank module of the app has no send hooks, so the tests call the hooks themselves
+	tokenfactoryKeeper := s.App.TokenfactoryKeeper
+	tokenfactoryKeeper.SetSendHooksRegistered()
+	s.msgServer = keeper.NewMsgServerImpl(tokenfactoryKeeper)
 	s.bankMsgServer = bankkeeper.NewMsgServerImpl(s.App.BankKeeper)
 }
 
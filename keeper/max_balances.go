@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetMaxBalance returns the maximum balance per address of a specific denom, and whether
+// the denom has one
+func (k Keeper) GetMaxBalance(ctx sdk.Context, denom string) (types.MaxBalance, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomMaxBalanceKey)
+	if bz == nil {
+		return types.MaxBalance{}, false
+	}
+
+	maxBalance := types.MaxBalance{}
+	if err := proto.Unmarshal(bz, &maxBalance); err != nil {
+		panic(err)
+	}
+	return maxBalance, true
+}
+
+// setMaxBalance sets the maximum balance per address of a denom. A zero amount removes the
+// maximum balance of the denom.
+func (k Keeper) setMaxBalance(ctx sdk.Context, denom string, maxBalance types.MaxBalance) error {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if maxBalance.Amount.IsNil() || maxBalance.Amount.IsZero() {
+		store.Delete(types.DenomMaxBalanceKey)
+		return nil
+	}
+
+	err := maxBalance.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&maxBalance)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.DenomMaxBalanceKey, bz)
+	return nil
+}
+
+// checkMaxBalance returns an error if addr would hold more than the maximum balance of a
+// denom after receiving amount of it
+func (k Keeper) checkMaxBalance(ctx sdk.Context, denom string, addr sdk.AccAddress, amount sdk.Int) error {
+	maxBalance, found := k.GetMaxBalance(ctx, denom)
+	if !found || maxBalance.IsExempt(addr) {
+		return nil
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom).Amount.Add(amount)
+	if balance.GT(maxBalance.Amount) {
+		return types.ErrMaxBalanceExceeded.Wrapf("balance of %s would be %s%s, above the max balance of %s%s", addr, balance, denom, maxBalance.Amount, denom)
+	}
+	return nil
+}
+
+// checkMaxBalances returns an error if to would hold more than the maximum balance of one of
+// the factory denoms in amount after receiving it from from
+func (k Keeper) checkMaxBalances(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	// a send to the sender itself doesn't change its balance
+	if from.Equals(to) {
+		return nil
+	}
+
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if err := k.checkMaxBalance(ctx, coin.Denom, to, coin.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
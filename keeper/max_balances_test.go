@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSetMaxBalance() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0]
+	maxBalance := types.MaxBalance{Amount: sdk.NewInt(100), ExemptAddresses: []string{admin.String()}}
+
+	// only the admin can set the max balance
+	_, err := s.msgServer.SetMaxBalance(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxBalance(s.TestAccs[1].String(), s.defaultDenom, maxBalance))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetMaxBalance(sdk.WrapSDKContext(ctx), types.NewMsgSetMaxBalance(admin.String(), s.defaultDenom, maxBalance))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetMaxBalance{}), 1)
+
+	res, err := s.queryClient.MaxBalance(s.Ctx.Context(), &types.QueryMaxBalanceRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(maxBalance, res.MaxBalance)
+
+	// a zero amount removes the max balance
+	_, err = s.msgServer.SetMaxBalance(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxBalance(admin.String(), s.defaultDenom, types.MaxBalance{Amount: sdk.ZeroInt()}))
+	s.Require().NoError(err)
+	_, found := s.App.TokenfactoryKeeper.GetMaxBalance(s.Ctx, s.defaultDenom)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestMaxBalanceEnforced() {
+	s.CreateDefaultDenom()
+	admin, holder, pool := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	hooks := s.App.TokenfactoryKeeper.Hooks()
+
+	_, err := s.msgServer.SetMaxBalance(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxBalance(admin.String(), s.defaultDenom, types.MaxBalance{
+		Amount:          sdk.NewInt(100),
+		ExemptAddresses: []string{pool.String()},
+	}))
+	s.Require().NoError(err)
+
+	// mints can't exceed the max balance of the recipient
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 80), holder.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 21), holder.String()))
+	s.Require().ErrorIs(err, types.ErrMaxBalanceExceeded)
+	s.Require().ErrorContains(err, "would be 101")
+
+	// exempt addresses have no max balance
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1000), pool.String()))
+	s.Require().NoError(err)
+
+	// neither can sends
+	s.Require().NoError(hooks.BlockBeforeSend(s.Ctx, pool, holder, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 20))))
+	s.Require().ErrorIs(hooks.BlockBeforeSend(s.Ctx, pool, holder, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 21))), types.ErrMaxBalanceExceeded)
+	s.Require().NoError(hooks.BlockBeforeSend(s.Ctx, holder, pool, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 80))))
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/osmosis-labs/tokenfactory/migrations/v2"
+	v3 "github.com/osmosis-labs/tokenfactory/migrations/v3"
+	v4 "github.com/osmosis-labs/tokenfactory/migrations/v4"
+	v5 "github.com/osmosis-labs/tokenfactory/migrations/v5"
+	v6 "github.com/osmosis-labs/tokenfactory/migrations/v6"
+	v7 "github.com/osmosis-labs/tokenfactory/migrations/v7"
+	v8 "github.com/osmosis-labs/tokenfactory/migrations/v8"
+	v9 "github.com/osmosis-labs/tokenfactory/migrations/v9"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateParams(ctx, m.keeper.paramSpace, m.keeper.bankKeeper)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if err := v5.MigrateStore(ctx, m.keeper.storeKey); err != nil {
+		return err
+	}
+	return v5.MigrateParams(ctx, m.keeper.paramSpace)
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateParams(ctx, m.keeper.paramSpace)
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateParams(ctx, m.keeper.paramSpace)
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateParams(ctx, m.keeper.paramSpace)
+}
+
+// Migrate8to9 migrates from version 8 to 9.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeKey, m.keeper.bankKeeper)
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/keeper"
+	v2 "github.com/osmosis-labs/tokenfactory/migrations/v2"
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// TestMigrations tests that state stored in the v1 key layout exports the same genesis
+// after migrating to the latest version
+func (s *KeeperTestSuite) TestMigrations() {
+	genesisDenoms := []types.GenesisDenom{}
+	for i, subdenom := range []string{"bitcoin", "litecoin"} {
+		for _, creator := range s.TestAccs[:2] {
+			denom, err := types.GetTokenDenom(creator.String(), subdenom)
+			s.Require().NoError(err)
+
+			// the second denom of each creator has its admin removed
+			admin := creator.String()
+			if i == 1 {
+				admin = ""
+			}
+
+			genesisDenoms = append(genesisDenoms, types.GenesisDenom{
+				Denom:             denom,
+				AuthorityMetadata: types.DenomAuthorityMetadata{Admin: admin},
+			})
+		}
+	}
+
+	// write the denoms in the v1 key layout
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+	for _, genDenom := range genesisDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		s.Require().NoError(err)
+
+		bz, err := proto.Marshal(&genDenom.AuthorityMetadata)
+		s.Require().NoError(err)
+
+		store.Set(append(v2.GetDenomPrefixStore(genDenom.Denom), v2.DenomAuthorityMetadataKey...), bz)
+		store.Set(append(v2.GetCreatorPrefix(creator), genDenom.Denom...), []byte(genDenom.Denom))
+	}
+
+	migrator := keeper.NewMigrator(s.App.TokenfactoryKeeper)
+	err := migrator.Migrate1to2(s.Ctx)
+	s.Require().NoError(err)
+
+	// the v1 keys are gone
+	for _, oldPrefix := range []string{v2.DenomsPrefixKey, v2.CreatorPrefixKey} {
+		iterator := prefix.NewStore(store, []byte(oldPrefix)).Iterator(nil, nil)
+		s.Require().False(iterator.Valid())
+		iterator.Close()
+	}
+
+	err = migrator.Migrate2to3(s.Ctx)
+	s.Require().NoError(err)
+
+	// denoms created before v3 get a creation record with only the creator set
+	for i, genDenom := range genesisDenoms {
+		creator, _, err := types.DeconstructDenom(genDenom.Denom)
+		s.Require().NoError(err)
+		genesisDenoms[i].CreationRecord = &types.DenomCreationRecord{Creator: creator}
+	}
+
+	// the reserved subdenom params didn't exist before v4
+	paramSpace, found := s.App.ParamsKeeper.GetSubspace(types.ModuleName)
+	s.Require().True(found)
+	paramStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	paramStore.Delete(types.KeyReservedSubdenoms)
+	paramStore.Delete(types.KeyReservedSubdenomExemptCreators)
+	s.Require().False(paramSpace.Has(s.Ctx, types.KeyReservedSubdenoms))
+
+	// the native denoms with a supply are reserved, but not the factory denoms
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin(genesisDenoms[1].Denom, 100))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, coins))
+
+	err = migrator.Migrate3to4(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(paramSpace.Has(s.Ctx, types.KeyReservedSubdenoms))
+	reservedSubdenoms := s.App.TokenfactoryKeeper.GetParams(s.Ctx).ReservedSubdenoms
+	s.Require().Contains(reservedSubdenoms, "uatom")
+	s.Require().NotContains(reservedSubdenoms, genesisDenoms[1].Denom)
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetParams(s.Ctx).ReservedSubdenomExemptCreators)
+	s.Require().NoError(s.App.BankKeeper.BurnCoins(s.Ctx, types.ModuleName, coins))
+
+	// the creation limit params didn't exist before v5
+	for _, key := range [][]byte{types.KeyMaxDenomsPerCreator, types.KeyMaxCreationsPerWindow, types.KeyCreationWindowBlocks} {
+		paramStore.Delete(key)
+	}
+
+	err = migrator.Migrate4to5(s.Ctx)
+	s.Require().NoError(err)
+	// the number of denoms of each creator is stored in v5
+	for _, creator := range s.TestAccs[:2] {
+		s.Require().Equal(sdk.Uint64ToBigEndian(2), store.Get(types.GetCreatorDenomCountKey(creator)))
+	}
+	s.Require().Nil(store.Get(types.GetCreatorDenomCountKey(s.TestAccs[2])))
+	s.Require().Equal(types.DefaultParams().MaxDenomsPerCreator, s.App.TokenfactoryKeeper.GetParams(s.Ctx).MaxDenomsPerCreator)
+	s.Require().Equal(types.DefaultParams().MaxCreationsPerWindow, s.App.TokenfactoryKeeper.GetParams(s.Ctx).MaxCreationsPerWindow)
+
+	// the deposit param didn't exist before v6
+	paramStore.Delete(types.KeyDenomCreationFeeIsDeposit)
+
+	err = migrator.Migrate5to6(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().False(s.App.TokenfactoryKeeper.GetParams(s.Ctx).DenomCreationFeeIsDeposit)
+
+	// the metadata validation params didn't exist before v7
+	paramStore.Delete(types.KeyDenomUnitPatterns)
+	paramStore.Delete(types.KeyReservedSymbols)
+
+	err = migrator.Migrate6to7(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(paramSpace.Has(s.Ctx, types.KeyDenomUnitPatterns))
+	s.Require().True(paramSpace.Has(s.Ctx, types.KeyReservedSymbols))
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetParams(s.Ctx).DenomUnitPatterns)
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetParams(s.Ctx).ReservedSymbols)
+
+	// the mint schedule params didn't exist before v8
+	paramStore.Delete(types.KeyMaxMintSchedulesPerBlock)
+	paramStore.Delete(types.KeyMaxMintSchedulesPerDenom)
+
+	err = migrator.Migrate7to8(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(paramSpace.Has(s.Ctx, types.KeyMaxMintSchedulesPerBlock))
+	s.Require().Equal(uint64(types.DefaultMaxMintSchedulesPerBlock), s.App.TokenfactoryKeeper.GetParams(s.Ctx).MaxMintSchedulesPerBlock)
+	s.Require().Equal(uint64(types.DefaultMaxMintSchedulesPerDenom), s.App.TokenfactoryKeeper.GetParams(s.Ctx).MaxMintSchedulesPerDenom)
+
+	// the holders of a denom before v9 become its snapshot holders
+	coins = sdk.NewCoins(sdk.NewInt64Coin(genesisDenoms[0].Denom, 100))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, coins))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, types.ModuleName, s.TestAccs[2], coins))
+
+	err = migrator.Migrate8to9(s.Ctx)
+	s.Require().NoError(err)
+	genesisDenoms[0].SnapshotHolders = []string{s.TestAccs[2].String()}
+
+	exportedGenesis := s.App.TokenfactoryKeeper.ExportGenesis(s.Ctx)
+	s.Require().ElementsMatch(genesisDenoms, exportedGenesis.FactoryDenoms)
+
+	for _, creator := range s.TestAccs[:2] {
+		res, err := s.queryClient.DenomsFromCreator(s.Ctx.Context(), &types.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+		s.Require().NoError(err)
+		s.Require().Len(res.Denoms, 2)
+	}
+}
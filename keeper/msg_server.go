@@ -4,7 +4,6 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/osmosis-labs/tokenfactory/types"
 )
@@ -37,6 +36,14 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateDenom{
+		Creator: msg.Sender,
+		Denom:   denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateDenomResponse{
 		NewTokenDenom: denom,
 	}, nil
@@ -46,8 +53,7 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// pay some extra gas cost to give a better error here.
-	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Amount.Denom)
-	if !denomExists {
+	if !server.Keeper.denomExists(ctx, msg.Amount.Denom) {
 		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
 	}
 
@@ -60,6 +66,15 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	// backed denoms are only minted and burned by wrapping and unwrapping their collateral
+	if server.Keeper.IsDenomBacked(ctx, msg.Amount.GetDenom()) {
+		return nil, types.ErrBackedDenom.Wrapf("denom: %s", msg.Amount.GetDenom())
+	}
+
 	if msg.MintToAddress == "" {
 		msg.MintToAddress = msg.Sender
 	}
@@ -77,6 +92,15 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		Sender:        msg.Sender,
+		MintToAddress: msg.MintToAddress,
+		Amount:        msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgMintResponse{}, nil
 }
 
@@ -92,13 +116,24 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	if server.Keeper.IsDenomBacked(ctx, msg.Amount.GetDenom()) {
+		return nil, types.ErrBackedDenom.Wrapf("denom: %s", msg.Amount.GetDenom())
+	}
+
 	if msg.BurnFromAddress == "" {
 		msg.BurnFromAddress = msg.Sender
 	}
 
-	accountI := server.Keeper.accountKeeper.GetAccount(ctx, sdk.AccAddress(msg.BurnFromAddress))
-	_, ok := accountI.(authtypes.ModuleAccountI)
-	if ok {
+	burnFromAddr, err := sdk.AccAddressFromBech32(msg.BurnFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.Keeper.isModuleOrBlockedAccount(ctx, burnFromAddr) {
 		return nil, types.ErrBurnFromModuleAccount
 	}
 
@@ -115,6 +150,15 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		Sender:          msg.Sender,
+		BurnFromAddress: msg.BurnFromAddress,
+		Amount:          msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgBurnResponse{}, nil
 }
 
@@ -130,6 +174,21 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	for _, address := range []string{msg.TransferFromAddress, msg.TransferToAddress} {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if server.Keeper.isModuleOrBlockedAccount(ctx, addr) {
+			return nil, types.ErrForceTransferModuleAccount.Wrapf("address: %s", address)
+		}
+	}
+
 	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
 	if err != nil {
 		return nil, err
@@ -144,6 +203,16 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventForceTransfer{
+		Sender:              msg.Sender,
+		TransferFromAddress: msg.TransferFromAddress,
+		TransferToAddress:   msg.TransferToAddress,
+		Amount:              msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgForceTransferResponse{}, nil
 }
 
@@ -159,6 +228,10 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
 	err = server.Keeper.setAdmin(ctx, msg.Denom, msg.NewAdmin)
 	if err != nil {
 		return nil, err
@@ -171,6 +244,15 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventChangeAdmin{
+		Sender:   msg.Sender,
+		Denom:    msg.Denom,
+		NewAdmin: msg.NewAdmin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgChangeAdminResponse{}, nil
 }
 
@@ -192,6 +274,20 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	err = server.Keeper.checkMetadataLock(ctx, authorityMetadata.GetMetadataLock(), msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.validateDenomMetadata(ctx, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -202,5 +298,1023 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		),
 	})
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetDenomMetadata{
+		Sender:   msg.Sender,
+		Denom:    msg.Metadata.Base,
+		Metadata: msg.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+func (server msgServer) UpdateReservedSubdenoms(goCtx context.Context, msg *types.MsgUpdateReservedSubdenoms) (*types.MsgUpdateReservedSubdenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != server.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", server.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	err := server.Keeper.updateReservedSubdenoms(ctx, msg.AddPatterns, msg.RemovePatterns, msg.AddExemptCreators, msg.RemoveExemptCreators)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdateReservedSubdenoms{
+		Authority:            msg.Authority,
+		AddPatterns:          msg.AddPatterns,
+		RemovePatterns:       msg.RemovePatterns,
+		AddExemptCreators:    msg.AddExemptCreators,
+		RemoveExemptCreators: msg.RemoveExemptCreators,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateReservedSubdenomsResponse{}, nil
+}
+
+func (server msgServer) DelistDenom(goCtx context.Context, msg *types.MsgDelistDenom) (*types.MsgDelistDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != server.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", server.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	forfeitedDeposit, err := server.Keeper.forfeitDenomDeposit(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setAdmin(ctx, msg.Denom, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDelistDenom{
+		Authority:        msg.Authority,
+		Denom:            msg.Denom,
+		ForfeitedDeposit: forfeitedDeposit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelistDenomResponse{}, nil
+}
+
+func (server msgServer) DeleteDenom(goCtx context.Context, msg *types.MsgDeleteDenom) (*types.MsgDeleteDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	// the bank metadata outlives the denom, so a denom with locked metadata needs a
+	// tombstone, or the metadata could be edited again after creating the denom again
+	if authorityMetadata.GetMetadataLock() != types.MetadataLockNone && !msg.Tombstone {
+		return nil, types.ErrDenomMetadataLocked.Wrapf("denom %s can only be deleted with a tombstone", msg.Denom)
+	}
+
+	refundedDeposit, err := server.Keeper.DeleteDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Tombstone {
+		server.Keeper.setDenomTombstone(ctx, msg.Denom)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDeleteDenom{
+		Sender:          msg.Sender,
+		Denom:           msg.Denom,
+		Tombstone:       msg.Tombstone,
+		RefundedDeposit: refundedDeposit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteDenomResponse{}, nil
+}
+
+func (server msgServer) GovSetDenomAdmin(goCtx context.Context, msg *types.MsgGovSetDenomAdmin) (*types.MsgGovSetDenomAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != server.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", server.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setAdmin(ctx, msg.Denom, msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGovSetDenomAdmin{
+		Authority:     msg.Authority,
+		Denom:         msg.Denom,
+		PreviousAdmin: authorityMetadata.GetAdmin(),
+		NewAdmin:      msg.NewAdmin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovSetDenomAdminResponse{}, nil
+}
+
+func (server msgServer) GovFreezeDenom(goCtx context.Context, msg *types.MsgGovFreezeDenom) (*types.MsgGovFreezeDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != server.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", server.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	err := server.Keeper.setFrozen(ctx, msg.Denom, msg.Frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGovFreezeDenom{
+		Authority: msg.Authority,
+		Denom:     msg.Denom,
+		Frozen:    msg.Frozen,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGovFreezeDenomResponse{}, nil
+}
+
+func (server msgServer) LockDenomMetadata(goCtx context.Context, msg *types.MsgLockDenomMetadata) (*types.MsgLockDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	lock := types.MetadataLockFull
+	if msg.Partial {
+		lock = types.MetadataLockPartial
+	}
+
+	// a lock can only be tightened, from a partial to a full lock
+	if authorityMetadata.GetMetadataLock() >= lock {
+		return nil, types.ErrDenomMetadataLocked.Wrapf("denom %s already has lock %s", msg.Denom, authorityMetadata.GetMetadataLock())
+	}
+
+	err = server.Keeper.setMetadataLock(ctx, msg.Denom, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockDenomMetadata{
+		Sender:       msg.Sender,
+		Denom:        msg.Denom,
+		MetadataLock: lock,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockDenomMetadataResponse{}, nil
+}
+
+func (server msgServer) SetTokenProfile(goCtx context.Context, msg *types.MsgSetTokenProfile) (*types.MsgSetTokenProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	err = server.Keeper.setTokenProfile(ctx, msg.Denom, msg.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetTokenProfile{
+		Sender:  msg.Sender,
+		Denom:   msg.Denom,
+		Profile: msg.Profile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTokenProfileResponse{}, nil
+}
+
+func (server msgServer) MintVesting(goCtx context.Context, msg *types.MsgMintVesting) (*types.MsgMintVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	if server.Keeper.IsDenomBacked(ctx, msg.Amount.GetDenom()) {
+		return nil, types.ErrBackedDenom.Wrapf("denom: %s", msg.Amount.GetDenom())
+	}
+
+	schedule, err := server.Keeper.mintVesting(ctx, msg.Recipient, msg.Amount, msg.StartTime, msg.CliffTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMintVesting{
+		Sender:   msg.Sender,
+		Schedule: schedule,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintVestingResponse{ScheduleID: schedule.ID}, nil
+}
+
+func (server msgServer) ClaimVested(goCtx context.Context, msg *types.MsgClaimVested) (*types.MsgClaimVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := server.Keeper.claimVested(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaimVested{
+		Recipient: msg.Recipient,
+		Claimed:   claimed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimVestedResponse{Claimed: claimed}, nil
+}
+
+func (server msgServer) CreateMintSchedule(goCtx context.Context, msg *types.MsgCreateMintSchedule) (*types.MsgCreateMintScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	if server.Keeper.IsDenomBacked(ctx, msg.Amount.GetDenom()) {
+		return nil, types.ErrBackedDenom.Wrapf("denom: %s", msg.Amount.GetDenom())
+	}
+
+	schedule, err := server.Keeper.createMintSchedule(ctx, msg.Sender, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCreateMintSchedule{
+		Sender:   msg.Sender,
+		Schedule: schedule,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMintScheduleResponse{ScheduleID: schedule.ID}, nil
+}
+
+func (server msgServer) CancelMintSchedule(goCtx context.Context, msg *types.MsgCancelMintSchedule) (*types.MsgCancelMintScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, found := server.Keeper.GetMintSchedule(ctx, msg.ScheduleID)
+	if !found {
+		return nil, types.ErrMintScheduleNotFound.Wrapf("id: %d", msg.ScheduleID)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, schedule.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	// the creator can cancel its schedule even after the admin changed
+	if msg.Sender != authorityMetadata.GetAdmin() && msg.Sender != schedule.Creator {
+		return nil, types.ErrUnauthorized
+	}
+
+	server.Keeper.deleteMintSchedule(ctx, schedule)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCancelMintSchedule{
+		Sender:     msg.Sender,
+		ScheduleID: schedule.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMintScheduleResponse{}, nil
+}
+
+func (server msgServer) TakeSnapshot(goCtx context.Context, msg *types.MsgTakeSnapshot) (*types.MsgTakeSnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	err = server.Keeper.checkSendHooksRegistered("taking snapshots")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := server.Keeper.takeSnapshot(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventTakeSnapshot{
+		Sender:   msg.Sender,
+		Denom:    msg.Denom,
+		Snapshot: snapshot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTakeSnapshotResponse{SnapshotID: snapshot.ID}, nil
+}
+
+func (server msgServer) DepositDistribution(goCtx context.Context, msg *types.MsgDepositDistribution) (*types.MsgDepositDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	err = server.Keeper.checkSendHooksRegistered("distributing rewards")
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.depositDistribution(ctx, sender, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDepositDistribution{
+		Sender: msg.Sender,
+		Denom:  msg.Denom,
+		Amount: msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositDistributionResponse{}, nil
+}
+
+func (server msgServer) ClaimDistribution(goCtx context.Context, msg *types.MsgClaimDistribution) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := server.Keeper.claimDistribution(ctx, msg.Denom, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaimDistribution{
+		Sender: msg.Sender,
+		Denom:  msg.Denom,
+		Amount: claimed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimDistributionResponse{Amount: claimed}, nil
+}
+
+func (server msgServer) SetHolderIndex(goCtx context.Context, msg *types.MsgSetHolderIndex) (*types.MsgSetHolderIndexResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	if msg.Enabled {
+		err = server.Keeper.checkSendHooksRegistered("the holder index")
+		if err != nil {
+			return nil, err
+		}
+		err = server.Keeper.enableHolderIndex(ctx, msg.Denom)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		server.Keeper.disableHolderIndex(ctx, msg.Denom)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetHolderIndex{
+		Sender:  msg.Sender,
+		Denom:   msg.Denom,
+		Enabled: msg.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetHolderIndexResponse{}, nil
+}
+
+func (server msgServer) RegisterConversionRoute(goCtx context.Context, msg *types.MsgRegisterConversionRoute) (*types.MsgRegisterConversionRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, denom := range []string{msg.SourceDenom, msg.TargetDenom} {
+		if !server.Keeper.denomExists(ctx, denom) {
+			return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+		}
+
+		authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		if msg.Sender != authorityMetadata.GetAdmin() {
+			return nil, types.ErrUnauthorized
+		}
+
+		if authorityMetadata.GetFrozen() {
+			return nil, types.ErrDenomFrozen
+		}
+
+		// the conversion would change the supply of a backed denom without its collateral
+		if server.Keeper.IsDenomBacked(ctx, denom) {
+			return nil, types.ErrBackedDenom.Wrapf("denom: %s", denom)
+		}
+	}
+
+	route := types.ConversionRoute{
+		Creator:     msg.Sender,
+		SourceDenom: msg.SourceDenom,
+		TargetDenom: msg.TargetDenom,
+		Ratio:       msg.Ratio,
+		Deadline:    msg.Deadline,
+	}
+	if route.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrInvalidConversionRoute.Wrapf("deadline %s has passed", msg.Deadline)
+	}
+
+	err := server.Keeper.setConversionRoute(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRegisterConversionRoute{
+		Sender: msg.Sender,
+		Route:  route,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterConversionRouteResponse{}, nil
+}
+
+func (server msgServer) Convert(goCtx context.Context, msg *types.MsgConvert) (*types.MsgConvertResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, err := server.Keeper.convert(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventConvert{
+		Sender: msg.Sender,
+		Burned: msg.Amount,
+		Minted: converted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertResponse{Converted: converted}, nil
+}
+
+func (server msgServer) SetDenomBacking(goCtx context.Context, msg *types.MsgSetDenomBacking) (*types.MsgSetDenomBackingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	err = server.Keeper.backDenom(ctx, msg.Denom, msg.CollateralDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetDenomBacking{
+		Sender:          msg.Sender,
+		Denom:           msg.Denom,
+		CollateralDenom: msg.CollateralDenom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomBackingResponse{}, nil
+}
+
+func (server msgServer) Wrap(goCtx context.Context, msg *types.MsgWrap) (*types.MsgWrapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collateral, err := server.Keeper.wrap(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWrap{
+		Sender:     msg.Sender,
+		Collateral: collateral,
+		Minted:     msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrapResponse{}, nil
+}
+
+func (server msgServer) Unwrap(goCtx context.Context, msg *types.MsgUnwrap) (*types.MsgUnwrapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collateral, err := server.Keeper.unwrap(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnwrap{
+		Sender:     msg.Sender,
+		Burned:     msg.Amount,
+		Collateral: collateral,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnwrapResponse{}, nil
+}
+
+func (server msgServer) SetTransferFee(goCtx context.Context, msg *types.MsgSetTransferFee) (*types.MsgSetTransferFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	if msg.Fee.BasisPoints != 0 {
+		err = server.Keeper.checkSendHooksRegistered("transfer fees")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = server.Keeper.setTransferFee(ctx, msg.Denom, msg.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetTransferFee{
+		Sender: msg.Sender,
+		Denom:  msg.Denom,
+		Fee:    msg.Fee,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTransferFeeResponse{}, nil
+}
+
+func (server msgServer) SetRestricted(goCtx context.Context, msg *types.MsgSetRestricted) (*types.MsgSetRestrictedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	if msg.Restricted {
+		err = server.Keeper.checkSendHooksRegistered("restricting transfers")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	server.Keeper.setRestricted(ctx, msg.Denom, msg.Restricted)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetRestricted{
+		Sender:     msg.Sender,
+		Denom:      msg.Denom,
+		Restricted: msg.Restricted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRestrictedResponse{}, nil
+}
+
+func (server msgServer) AddToAllowlist(goCtx context.Context, msg *types.MsgAddToAllowlist) (*types.MsgAddToAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	for _, addr := range msg.Addresses {
+		server.Keeper.addToAllowlist(ctx, msg.Denom, sdk.MustAccAddressFromBech32(addr))
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAddToAllowlist{
+		Sender:    msg.Sender,
+		Denom:     msg.Denom,
+		Addresses: msg.Addresses,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToAllowlistResponse{}, nil
+}
+
+func (server msgServer) RemoveFromAllowlist(goCtx context.Context, msg *types.MsgRemoveFromAllowlist) (*types.MsgRemoveFromAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	for _, addr := range msg.Addresses {
+		server.Keeper.removeFromAllowlist(ctx, msg.Denom, sdk.MustAccAddressFromBech32(addr))
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRemoveFromAllowlist{
+		Sender:    msg.Sender,
+		Denom:     msg.Denom,
+		Addresses: msg.Addresses,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFromAllowlistResponse{}, nil
+}
+
+func (server msgServer) SetMaxBalance(goCtx context.Context, msg *types.MsgSetMaxBalance) (*types.MsgSetMaxBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.GetFrozen() {
+		return nil, types.ErrDenomFrozen
+	}
+
+	if !msg.MaxBalance.Amount.IsNil() && !msg.MaxBalance.Amount.IsZero() {
+		err = server.Keeper.checkSendHooksRegistered("max balances")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = server.Keeper.setMaxBalance(ctx, msg.Denom, msg.MaxBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSetMaxBalance{
+		Sender:     msg.Sender,
+		Denom:      msg.Denom,
+		MaxBalance: msg.MaxBalance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMaxBalanceResponse{}, nil
+}
+
+func (server msgServer) Approve(goCtx context.Context, msg *types.MsgApprove) (*types.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	allowance := types.Allowance{
+		Owner:      msg.Sender,
+		Spender:    msg.Spender,
+		Amount:     msg.Amount,
+		Expiration: msg.Expiration,
+	}
+	err := server.Keeper.approve(ctx, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventApprove{
+		Allowance: allowance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveResponse{}, nil
+}
+
+func (server msgServer) TransferFrom(goCtx context.Context, msg *types.MsgTransferFrom) (*types.MsgTransferFromResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.Keeper.denomExists(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
+	spender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.transferFrom(ctx, spender, owner, recipient, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventTransferFrom{
+		Spender:   msg.Sender,
+		Owner:     msg.Owner,
+		Recipient: msg.Recipient,
+		Amount:    msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferFromResponse{}, nil
+}
+
+func (server msgServer) RevokeApproval(goCtx context.Context, msg *types.MsgRevokeApproval) (*types.MsgRevokeApprovalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	spender, err := sdk.AccAddressFromBech32(msg.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := server.Keeper.GetAllowance(ctx, msg.Denom, owner, spender); !found {
+		return nil, types.ErrAllowanceNotFound.Wrapf("denom: %s, owner: %s, spender: %s", msg.Denom, owner, spender)
+	}
+	server.Keeper.deleteAllowance(ctx, msg.Denom, owner, spender)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRevokeApproval{
+		Owner:   msg.Sender,
+		Spender: msg.Spender,
+		Denom:   msg.Denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeApprovalResponse{}, nil
+}
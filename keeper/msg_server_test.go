@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/osmosis-labs/tokenfactory/types"
 )
 
@@ -49,6 +50,7 @@ func (s *KeeperTestSuite) TestMintDenomMsg() {
 			}
 			// Ensure current number and type of event is emitted
 			s.AssertEventEmitted(ctx, types.TypeMsgMint, tc.expectedMessageEvents)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventMint{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -95,6 +97,7 @@ func (s *KeeperTestSuite) TestBurnDenomMsg() {
 			}
 			// Ensure current number and type of event is emitted
 			s.AssertEventEmitted(ctx, types.TypeMsgBurn, tc.expectedMessageEvents)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventBurn{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -133,6 +136,7 @@ func (s *KeeperTestSuite) TestCreateDenomMsg() {
 			}
 			// Ensure current number and type of event is emitted
 			s.AssertEventEmitted(ctx, types.TypeMsgCreateDenom, tc.expectedMessageEvents)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateDenom{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -190,6 +194,7 @@ func (s *KeeperTestSuite) TestChangeAdminDenomMsg() {
 			}
 			// Ensure current number and type of event is emitted
 			s.AssertEventEmitted(ctx, types.TypeMsgChangeAdmin, tc.expectedMessageEvents)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventChangeAdmin{}), tc.expectedMessageEvents)
 		})
 	}
 }
@@ -216,12 +221,12 @@ func (s *KeeperTestSuite) TestSetDenomMetaDataMsg() {
 						Exponent: 0,
 					},
 					{
-						Denom:    "uosmo",
+						Denom:    s.defaultDenom + "/osmo",
 						Exponent: 6,
 					},
 				},
 				Base:    s.defaultDenom,
-				Display: "uosmo",
+				Display: s.defaultDenom + "/osmo",
 				Name:    "OSMO",
 				Symbol:  "OSMO",
 			}),
@@ -262,6 +267,136 @@ func (s *KeeperTestSuite) TestSetDenomMetaDataMsg() {
 			}
 			// Ensure current number and type of event is emitted
 			s.AssertEventEmitted(ctx, types.TypeMsgSetDenomMetadata, tc.expectedMessageEvents)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetDenomMetadata{}), tc.expectedMessageEvents)
+		})
+	}
+}
+
+// TestTypedEvents tests that typed events carry the sender of the message
+func (s *KeeperTestSuite) TestTypedEvents() {
+	s.CreateDefaultDenom()
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	amount := sdk.NewInt64Coin(s.defaultDenom, 10)
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMintTo(s.TestAccs[0].String(), amount, s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurnFrom(s.TestAccs[0].String(), amount, s.TestAccs[1].String()))
+	s.Require().NoError(err)
+
+	typedEvents := []proto.Message{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(&types.EventMint{}) && event.Type != proto.MessageName(&types.EventBurn{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		s.Require().NoError(err)
+		typedEvents = append(typedEvents, typedEvent)
+	}
+
+	s.Require().Equal([]proto.Message{
+		&types.EventMint{Sender: s.TestAccs[0].String(), MintToAddress: s.TestAccs[1].String(), Amount: amount},
+		&types.EventBurn{Sender: s.TestAccs[0].String(), BurnFromAddress: s.TestAccs[1].String(), Amount: amount},
+	}, typedEvents)
+}
+
+// TestDeleteDenomMsg tests that only the admin can delete a denom, that denoms with locked
+// metadata need a tombstone, and that tombstoned denoms can't be created again
+func (s *KeeperTestSuite) TestDeleteDenomMsg() {
+	for _, tc := range []struct {
+		desc      string
+		sender    func() string
+		lock      bool
+		tombstone bool
+		expectErr error
+	}{
+		{
+			desc:      "non-admin can't delete denom",
+			sender:    func() string { return s.TestAccs[1].String() },
+			expectErr: types.ErrUnauthorized,
+		},
+		{
+			desc:   "admin deletes denom",
+			sender: func() string { return s.TestAccs[0].String() },
+		},
+		{
+			desc:      "admin deletes denom with a tombstone",
+			sender:    func() string { return s.TestAccs[0].String() },
+			tombstone: true,
+		},
+		{
+			desc:      "admin can't delete denom with locked metadata without a tombstone",
+			sender:    func() string { return s.TestAccs[0].String() },
+			lock:      true,
+			expectErr: types.ErrDenomMetadataLocked,
+		},
+		{
+			desc:      "admin deletes denom with locked metadata with a tombstone",
+			sender:    func() string { return s.TestAccs[0].String() },
+			lock:      true,
+			tombstone: true,
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			if tc.lock {
+				_, err := s.msgServer.LockDenomMetadata(sdk.WrapSDKContext(s.Ctx), types.NewMsgLockDenomMetadata(s.TestAccs[0].String(), s.defaultDenom, false))
+				s.Require().NoError(err)
+			}
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := s.msgServer.DeleteDenom(sdk.WrapSDKContext(ctx), types.NewMsgDeleteDenom(tc.sender(), s.defaultDenom, tc.tombstone))
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				s.AssertEventEmitted(ctx, proto.MessageName(&types.EventDeleteDenom{}), 0)
+				return
+			}
+			s.Require().NoError(err)
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventDeleteDenom{}), 1)
+
+			authorityMetadata, err := s.App.TokenfactoryKeeper.GetAuthorityMetadata(s.Ctx, s.defaultDenom)
+			s.Require().NoError(err)
+			s.Require().Empty(authorityMetadata.Admin)
+			_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+			s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+
+			_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+			if tc.tombstone {
+				s.Require().ErrorIs(err, types.ErrDenomTombstoned)
+				s.Require().Equal([]string{s.defaultDenom}, s.App.TokenfactoryKeeper.ExportGenesis(s.Ctx).TombstonedDenoms)
+			} else {
+				s.Require().NoError(err)
+			}
 		})
 	}
 }
+
+// TestDeleteDenomRemovesSchedules tests that deleting a denom removes its mint and vesting
+// schedules
+func (s *KeeperTestSuite) TestDeleteDenomRemovesSchedules() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.msgServer.CreateMintSchedule(goCtx, types.NewMsgCreateMintSchedule(admin, s.TestAccs[1].String(), sdk.NewInt64Coin(s.defaultDenom, 100), 10, 0, 0, sdk.ZeroDec()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MintVesting(goCtx, types.NewMsgMintVesting(admin, s.TestAccs[1].String(), sdk.NewInt64Coin(s.defaultDenom, 100), s.Ctx.BlockTime(), s.Ctx.BlockTime(), s.Ctx.BlockTime().Add(1000)))
+	s.Require().NoError(err)
+
+	// the escrow of the vesting schedule is part of the supply, so it has to be gone for the
+	// denom to be deleted
+	_, err = s.msgServer.DeleteDenom(goCtx, types.NewMsgDeleteDenom(admin, s.defaultDenom, false))
+	s.Require().ErrorIs(err, types.ErrDenomHasSupply)
+	err = s.App.BankKeeper.BurnCoins(s.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.DeleteDenom(goCtx, types.NewMsgDeleteDenom(admin, s.defaultDenom, false))
+	s.Require().NoError(err)
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetMintSchedules(s.Ctx, s.defaultDenom))
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetAllVestingSchedules(s.Ctx))
+
+	// a denom created again with the same name doesn't inherit the schedules
+	_, err = s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetMintSchedules(s.Ctx, s.defaultDenom))
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetTokenProfile returns the extended profile of a specific denom, and whether the denom
+// has one.
+func (k Keeper) GetTokenProfile(ctx sdk.Context, denom string) (types.TokenProfile, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomTokenProfileKey)
+	if bz == nil {
+		return types.TokenProfile{}, false
+	}
+
+	profile := types.TokenProfile{}
+	if err := proto.Unmarshal(bz, &profile); err != nil {
+		panic(err)
+	}
+	return profile, true
+}
+
+// setTokenProfile stores the extended profile of a specific denom
+func (k Keeper) setTokenProfile(ctx sdk.Context, denom string, profile types.TokenProfile) error {
+	err := profile.Validate()
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	bz, err := proto.Marshal(&profile)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.DenomTokenProfileKey, bz)
+	return nil
+}
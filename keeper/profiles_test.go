@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSetTokenProfile() {
+	s.CreateDefaultDenom()
+	profile := types.TokenProfile{
+		LogoURI:     "ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		LogoURIHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Website:     "https://bitcoin.org",
+		Description: "A peer-to-peer electronic cash system",
+		Tags:        []string{"payments", "store-of-value"},
+		Contact:     "satoshin@gmx.com",
+	}
+
+	// no profile is set on denom creation
+	_, err := s.queryClient.TokenProfile(s.Ctx.Context(), &types.QueryTokenProfileRequest{Denom: s.defaultDenom})
+	s.Require().Error(err)
+
+	// only the admin can set the profile
+	_, err = s.msgServer.SetTokenProfile(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetTokenProfile(s.TestAccs[1].String(), s.defaultDenom, profile))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetTokenProfile(sdk.WrapSDKContext(ctx), types.NewMsgSetTokenProfile(s.TestAccs[0].String(), s.defaultDenom, profile))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetTokenProfile{}), 1)
+
+	res, err := s.queryClient.TokenProfile(s.Ctx.Context(), &types.QueryTokenProfileRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(profile, res.Profile)
+
+	// profiles over the size limits are rejected
+	invalidProfile := profile
+	invalidProfile.Tags = make([]string, types.MaxTokenProfileTags+1)
+	_, err = s.msgServer.SetTokenProfile(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetTokenProfile(s.TestAccs[0].String(), s.defaultDenom, invalidProfile))
+	s.Require().ErrorIs(err, types.ErrInvalidTokenProfile)
+
+	// the profile is removed with the denom
+	_, err = s.App.TokenfactoryKeeper.DeleteDenom(s.Ctx, s.defaultDenom)
+	s.Require().NoError(err)
+	_, found := s.App.TokenfactoryKeeper.GetTokenProfile(s.Ctx, s.defaultDenom)
+	s.Require().False(found)
+}
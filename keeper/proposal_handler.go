@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// NewProposalHandler returns the gov handler of the tokenfactory proposals. The gov module of
+// this SDK version can't execute messages, so the proposals are executed as the authority
+// messages they correspond to, sent by the module authority, which must be the gov module
+// account.
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	msgServer := NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		goCtx := sdk.WrapSDKContext(ctx)
+
+		switch c := content.(type) {
+		case *types.UpdateReservedSubdenomsProposal:
+			msg := types.NewMsgUpdateReservedSubdenoms(k.GetAuthority(), c.AddPatterns, c.RemovePatterns, c.AddExemptCreators, c.RemoveExemptCreators)
+			_, err := msgServer.UpdateReservedSubdenoms(goCtx, msg)
+			return err
+
+		case *types.DelistDenomProposal:
+			_, err := msgServer.DelistDenom(goCtx, types.NewMsgDelistDenom(k.GetAuthority(), c.Denom))
+			return err
+
+		case *types.SetDenomAdminProposal:
+			_, err := msgServer.GovSetDenomAdmin(goCtx, types.NewMsgGovSetDenomAdmin(k.GetAuthority(), c.Denom, c.NewAdmin))
+			return err
+
+		case *types.FreezeDenomProposal:
+			_, err := msgServer.GovFreezeDenom(goCtx, types.NewMsgGovFreezeDenom(k.GetAuthority(), c.Denom, c.Frozen))
+			return err
+
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized tokenfactory proposal content type: %T", c)
+		}
+	}
+}
+
+// NewParamChangeProposalHandler wraps the param change proposal handler of the params module, so
+// that the tokenfactory params are validated together once a proposal changed them. The params
+// module only validates each changed param on its own, so it can't check rules between params,
+// such as CreationWindowBlocks being set when MaxCreationsPerWindow is. A proposal that breaks
+// them fails, and gov discards its changes.
+func NewParamChangeProposalHandler(k Keeper, paramChangeHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := paramChangeHandler(ctx, content); err != nil {
+			return err
+		}
+
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+
+		for _, change := range c.Changes {
+			if change.Subspace == types.ModuleName {
+				if err := k.GetParams(ctx).Validate(); err != nil {
+					return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s params: %s", types.ModuleName, err)
+				}
+				return nil
+			}
+		}
+		return nil
+	}
+}
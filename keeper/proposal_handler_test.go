@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/osmosis-labs/tokenfactory/keeper"
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestProposalHandler() {
+	s.CreateDefaultDenom()
+	handler := keeper.NewProposalHandler(s.App.TokenfactoryKeeper)
+
+	// the proposals are routed to the handler by the gov module of the app
+	_, err := s.App.GovKeeper.SubmitProposal(s.Ctx, types.NewFreezeDenomProposal("title", "description", s.defaultDenom, true))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = handler(ctx, types.NewUpdateReservedSubdenomsProposal("title", "description", []string{"usd*"}, nil, []string{s.TestAccs[1].String()}, nil))
+	s.Require().NoError(err)
+	params := s.App.TokenfactoryKeeper.GetParams(ctx)
+	s.Require().Contains(params.ReservedSubdenoms, "usd*")
+	s.Require().Equal([]string{s.TestAccs[1].String()}, params.ReservedSubdenomExemptCreators)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventUpdateReservedSubdenoms{}), 1)
+
+	err = handler(s.Ctx, types.NewFreezeDenomProposal("title", "description", s.defaultDenom, true))
+	s.Require().NoError(err)
+	authorityMetadata, err := s.App.TokenfactoryKeeper.GetAuthorityMetadata(s.Ctx, s.defaultDenom)
+	s.Require().NoError(err)
+	s.Require().True(authorityMetadata.Frozen)
+
+	err = handler(s.Ctx, types.NewSetDenomAdminProposal("title", "description", s.defaultDenom, s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	authorityMetadata, err = s.App.TokenfactoryKeeper.GetAuthorityMetadata(s.Ctx, s.defaultDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(s.TestAccs[1].String(), authorityMetadata.Admin)
+
+	err = handler(s.Ctx, types.NewDelistDenomProposal("title", "description", s.defaultDenom))
+	s.Require().NoError(err)
+	authorityMetadata, err = s.App.TokenfactoryKeeper.GetAuthorityMetadata(s.Ctx, s.defaultDenom)
+	s.Require().NoError(err)
+	s.Require().Empty(authorityMetadata.Admin)
+
+	// errors of the messages fail the proposals
+	err = handler(s.Ctx, types.NewDelistDenomProposal("title", "description", "factory/"+s.TestAccs[0].String()+"/missing"))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	err = handler(s.Ctx, govtypes.NewTextProposal("title", "description"))
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestParamChangeProposalHandler() {
+	handler := keeper.NewParamChangeProposalHandler(s.App.TokenfactoryKeeper, params.NewParamChangeProposalHandler(s.App.ParamsKeeper))
+
+	// a creation rate limit without a window is rejected, even though each param is valid
+	proposal := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMaxCreationsPerWindow), `"5"`),
+	})
+	_, err := s.App.GovKeeper.SubmitProposal(s.Ctx, proposal)
+	s.Require().ErrorIs(err, govtypes.ErrInvalidProposalContent)
+	err = handler(s.Ctx, proposal)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	proposal = paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMaxCreationsPerWindow), `"5"`),
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyCreationWindowBlocks), `"100"`),
+	})
+	_, err = s.App.GovKeeper.SubmitProposal(s.Ctx, proposal)
+	s.Require().NoError(err)
+	err = handler(s.Ctx, proposal)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), s.App.TokenfactoryKeeper.GetParams(s.Ctx).MaxCreationsPerWindow)
+	s.Require().Equal(uint64(100), s.App.TokenfactoryKeeper.GetParams(s.Ctx).CreationWindowBlocks)
+}
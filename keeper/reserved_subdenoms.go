@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// isReservedSubdenom returns whether subdenom matches one of the reserved subdenom
+// patterns of the params
+func (k Keeper) isReservedSubdenom(params types.Params, subdenom string) bool {
+	for _, pattern := range params.ReservedSubdenoms {
+		if types.MatchReservedSubdenomPattern(pattern, subdenom) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReservedSubdenomExempt returns whether creator is allowed to use reserved subdenoms
+func (k Keeper) isReservedSubdenomExempt(params types.Params, creator sdk.AccAddress) bool {
+	for _, exemptCreator := range params.ReservedSubdenomExemptCreators {
+		if creator.Equals(sdk.MustAccAddressFromBech32(exemptCreator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateReservedSubdenoms removes and adds reserved subdenom patterns and exempt creators
+// in the params. Removing a pattern that isn't reserved fails, while adding a pattern or
+// a creator twice has no effect.
+func (k Keeper) updateReservedSubdenoms(ctx sdk.Context, addPatterns, removePatterns, addExemptCreators, removeExemptCreators []string) error {
+	params := k.GetParams(ctx)
+
+	for _, pattern := range removePatterns {
+		patterns, found := removeString(params.ReservedSubdenoms, pattern)
+		if !found {
+			return types.ErrInvalidReservedSubdenom.Wrapf("pattern %s is not reserved", pattern)
+		}
+		params.ReservedSubdenoms = patterns
+	}
+	for _, pattern := range addPatterns {
+		if _, found := removeString(params.ReservedSubdenoms, pattern); !found {
+			params.ReservedSubdenoms = append(params.ReservedSubdenoms, pattern)
+		}
+	}
+
+	for _, creator := range removeExemptCreators {
+		params.ReservedSubdenomExemptCreators, _ = removeString(params.ReservedSubdenomExemptCreators, creator)
+	}
+	for _, creator := range addExemptCreators {
+		if _, found := removeString(params.ReservedSubdenomExemptCreators, creator); !found {
+			params.ReservedSubdenomExemptCreators = append(params.ReservedSubdenomExemptCreators, creator)
+		}
+	}
+
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidReservedSubdenom.Wrap(err.Error())
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// removeString returns list without s, and whether s was in list
+func removeString(list []string, s string) ([]string, bool) {
+	for i, item := range list {
+		if item == s {
+			return append(append([]string{}, list[:i]...), list[i+1:]...), true
+		}
+	}
+	return list, false
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateReservedSubdenoms() {
+	authority := s.App.TokenfactoryKeeper.GetAuthority()
+	creator, exemptCreator := s.TestAccs[0], s.TestAccs[1]
+
+	// only the authority can update the reserved subdenoms
+	_, err := s.msgServer.UpdateReservedSubdenoms(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateReservedSubdenoms(
+		creator.String(), []string{"usdc"}, nil, nil, nil,
+	))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.UpdateReservedSubdenoms(sdk.WrapSDKContext(ctx), types.NewMsgUpdateReservedSubdenoms(
+		authority, []string{"usdc", "atom*"}, nil, []string{exemptCreator.String()}, nil,
+	))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventUpdateReservedSubdenoms{}), 1)
+
+	res, err := s.queryClient.ReservedSubdenoms(s.Ctx.Context(), &types.QueryReservedSubdenomsRequest{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]string{"usdc", "atom*"}, res.Patterns)
+	s.Require().Equal([]string{exemptCreator.String()}, res.ExemptCreators)
+
+	// reserved subdenoms can only be created by exempt creators
+	for _, subdenom := range []string{"usdc", "atom", "atom/staked"} {
+		_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(creator.String(), subdenom))
+		s.Require().ErrorIs(err, types.ErrSubdenomReserved)
+
+		_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(exemptCreator.String(), subdenom))
+		s.Require().NoError(err)
+	}
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(creator.String(), "usdc2"))
+	s.Require().NoError(err)
+
+	availability, err := s.queryClient.SubdenomAvailability(s.Ctx.Context(), &types.QuerySubdenomAvailabilityRequest{
+		Creator:  creator.String(),
+		Subdenom: "atomic",
+	})
+	s.Require().NoError(err)
+	s.Require().False(availability.Available)
+
+	// removing a pattern that isn't reserved fails
+	_, err = s.msgServer.UpdateReservedSubdenoms(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateReservedSubdenoms(
+		authority, nil, []string{"usdt"}, nil, nil,
+	))
+	s.Require().ErrorIs(err, types.ErrInvalidReservedSubdenom)
+
+	_, err = s.msgServer.UpdateReservedSubdenoms(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateReservedSubdenoms(
+		authority, nil, []string{"atom*"}, nil, []string{exemptCreator.String()},
+	))
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.ReservedSubdenoms(s.Ctx.Context(), &types.QueryReservedSubdenomsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"usdc"}, res.Patterns)
+	s.Require().Empty(res.ExemptCreators)
+
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(creator.String(), "atomic"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(exemptCreator.String(), "usdc/wrapped"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(creator.String(), "usdc"))
+	s.Require().ErrorIs(err, types.ErrSubdenomReserved)
+}
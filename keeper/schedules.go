@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetMintSchedule returns a specific mint schedule
+func (k Keeper) GetMintSchedule(ctx sdk.Context, id uint64) (types.MintSchedule, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMintScheduleKey(id))
+	if bz == nil {
+		return types.MintSchedule{}, false
+	}
+
+	schedule := types.MintSchedule{}
+	if err := proto.Unmarshal(bz, &schedule); err != nil {
+		panic(err)
+	}
+	return schedule, true
+}
+
+// GetMintSchedules returns the mint schedules of a specific denom
+func (k Keeper) GetMintSchedules(ctx sdk.Context, denom string) []types.MintSchedule {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomMintSchedulePrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	schedules := []types.MintSchedule{}
+	for ; iterator.Valid(); iterator.Next() {
+		schedule, found := k.GetMintSchedule(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if !found {
+			panic(fmt.Sprintf("mint schedule %d of denom %s not found", sdk.BigEndianToUint64(iterator.Key()), denom))
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// getMintScheduleCount returns the number of mint schedules of a specific denom
+func (k Keeper) getMintScheduleCount(ctx sdk.Context, denom string) uint64 {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomMintSchedulePrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetAllMintSchedules returns the mint schedules of all denoms
+func (k Keeper) GetAllMintSchedules(ctx sdk.Context) []types.MintSchedule {
+	schedules := []types.MintSchedule{}
+	k.iterateMintSchedules(ctx, nil, nil, func(schedule types.MintSchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+	return schedules
+}
+
+// iterateMintSchedules calls cb on the mint schedules with an ID in [start, end) in ascending
+// order of ID, until cb returns true. A nil start or end is unbounded.
+func (k Keeper) iterateMintSchedules(ctx sdk.Context, start, end []byte, cb func(types.MintSchedule) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintSchedulePrefixKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		schedule := types.MintSchedule{}
+		if err := proto.Unmarshal(iterator.Value(), &schedule); err != nil {
+			panic(err)
+		}
+		if cb(schedule) {
+			return
+		}
+	}
+}
+
+func (k Keeper) setMintSchedule(ctx sdk.Context, schedule types.MintSchedule) error {
+	err := schedule.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&schedule)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetMintScheduleKey(schedule.ID), bz)
+	// the schedules of a denom are indexed by denom, so that they can be found without
+	// iterating over the schedules of all denoms
+	k.GetDenomPrefixStore(ctx, schedule.Amount.Denom).Set(types.GetDenomMintScheduleKey(schedule.ID), []byte{})
+	return nil
+}
+
+func (k Keeper) deleteMintSchedule(ctx sdk.Context, schedule types.MintSchedule) {
+	ctx.KVStore(k.storeKey).Delete(types.GetMintScheduleKey(schedule.ID))
+	k.GetDenomPrefixStore(ctx, schedule.Amount.Denom).Delete(types.GetDenomMintScheduleKey(schedule.ID))
+}
+
+// deleteMintSchedules removes the mint schedules of denom
+func (k Keeper) deleteMintSchedules(ctx sdk.Context, denom string) {
+	for _, schedule := range k.GetMintSchedules(ctx, denom) {
+		k.deleteMintSchedule(ctx, schedule)
+	}
+}
+
+// GetNextMintScheduleID returns the ID of the next mint schedule
+func (k Keeper) GetNextMintScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextMintScheduleIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextMintScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextMintScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) getMintScheduleCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MintScheduleCursorKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setMintScheduleCursor(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MintScheduleCursorKey, sdk.Uint64ToBigEndian(id))
+}
+
+// createMintSchedule stores a new mint schedule of creator, whose first execution is one
+// period after the current block. The number of schedules of a denom is limited by the
+// MaxMintSchedulesPerDenom param, as every schedule is visited in EndBlock.
+func (k Keeper) createMintSchedule(ctx sdk.Context, creator string, msg *types.MsgCreateMintSchedule) (types.MintSchedule, error) {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(msg.Amount.Denom)
+	if err != nil {
+		return types.MintSchedule{}, err
+	}
+
+	maxSchedules := k.GetParams(ctx).MaxMintSchedulesPerDenom
+	if maxSchedules != 0 && k.getMintScheduleCount(ctx, msg.Amount.Denom) >= maxSchedules {
+		return types.MintSchedule{}, types.ErrTooManyMintSchedules.Wrapf("denom %s has %d mint schedules", msg.Amount.Denom, maxSchedules)
+	}
+
+	decayRate := msg.DecayRate
+	if decayRate.IsNil() {
+		decayRate = sdk.ZeroDec()
+	}
+
+	id := k.GetNextMintScheduleID(ctx)
+	schedule := types.MintSchedule{
+		ID:             id,
+		Creator:        creator,
+		Recipient:      msg.Recipient,
+		Amount:         msg.Amount,
+		PeriodBlocks:   msg.PeriodBlocks,
+		PeriodSeconds:  msg.PeriodSeconds,
+		RemainingMints: msg.MaxMints,
+		DecayRate:      decayRate,
+	}
+	if schedule.PeriodBlocks != 0 {
+		schedule.NextHeight = ctx.BlockHeight() + int64(schedule.PeriodBlocks)
+	} else {
+		schedule.NextTime = ctx.BlockTime().Add(time.Duration(schedule.PeriodSeconds) * time.Second)
+	}
+
+	err = k.setMintSchedule(ctx, schedule)
+	if err != nil {
+		return types.MintSchedule{}, err
+	}
+	k.setNextMintScheduleID(ctx, id+1)
+
+	return schedule, nil
+}
+
+// ExecuteMintSchedules visits at most MaxMintSchedulesPerBlock mint schedules, starting
+// after the last schedule visited in the previous block, and executes the ones that are
+// due. A schedule mints once per visit, so periods that elapsed while it was not visited are
+// dropped rather than caught up. Schedules of deleted or backed denoms and schedules whose
+// creator is no longer the admin of the denom are removed. Schedules whose mint fails skip the
+// period and are retried one period later. Schedules of frozen denoms are kept due until the
+// denom is unfrozen.
+func (k Keeper) ExecuteMintSchedules(ctx sdk.Context) {
+	budget := k.GetParams(ctx).MaxMintSchedulesPerBlock
+	if budget == 0 {
+		return
+	}
+
+	cursor := k.getMintScheduleCursor(ctx)
+	visited := []types.MintSchedule{}
+	collect := func(schedule types.MintSchedule) bool {
+		visited = append(visited, schedule)
+		return uint64(len(visited)) >= budget
+	}
+	k.iterateMintSchedules(ctx, sdk.Uint64ToBigEndian(cursor), nil, collect)
+	if uint64(len(visited)) < budget {
+		// wrap around to the schedules before the cursor
+		k.iterateMintSchedules(ctx, nil, sdk.Uint64ToBigEndian(cursor), collect)
+	}
+	if len(visited) == 0 {
+		return
+	}
+	k.setMintScheduleCursor(ctx, visited[len(visited)-1].ID+1)
+
+	for _, schedule := range visited {
+		if !schedule.IsDue(ctx.BlockHeight(), ctx.BlockTime()) {
+			continue
+		}
+		k.executeMintSchedule(ctx, schedule)
+	}
+}
+
+func (k Keeper) executeMintSchedule(ctx sdk.Context, schedule types.MintSchedule) {
+	if !k.denomExists(ctx, schedule.Amount.Denom) {
+		k.deleteMintSchedule(ctx, schedule)
+		return
+	}
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, schedule.Amount.Denom)
+	if err != nil || authorityMetadata.GetAdmin() != schedule.Creator {
+		k.deleteMintSchedule(ctx, schedule)
+		return
+	}
+	if authorityMetadata.GetFrozen() {
+		return
+	}
+	if k.IsDenomBacked(ctx, schedule.Amount.Denom) {
+		k.deleteMintSchedule(ctx, schedule)
+		return
+	}
+
+	// the mint is executed in a cached context, so that a failing mint has no effect
+	cacheCtx, write := ctx.CacheContext()
+	err = k.mintTo(cacheCtx, schedule.Amount, schedule.Recipient)
+	if err != nil {
+		k.skipMintSchedule(ctx, schedule, err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	minted := schedule.Amount
+	ended := schedule.Advance(ctx.BlockHeight(), ctx.BlockTime())
+	if ended {
+		k.deleteMintSchedule(ctx, schedule)
+	} else if err := k.setMintSchedule(ctx, schedule); err != nil {
+		k.Logger(ctx).Error("failed to update mint schedule", "id", schedule.ID, "error", err)
+		k.deleteMintSchedule(ctx, schedule)
+		ended = true
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventScheduledMint{
+		ScheduleID: schedule.ID,
+		Recipient:  schedule.Recipient,
+		Amount:     minted,
+		Ended:      ended,
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to emit scheduled mint event", "id", schedule.ID, "error", err)
+	}
+}
+
+// skipMintSchedule moves the next execution of a schedule whose mint failed to one period
+// later, without counting the execution, such that the mint is retried instead of the
+// schedule being removed, e.g. when the recipient is not allowlisted yet.
+func (k Keeper) skipMintSchedule(ctx sdk.Context, schedule types.MintSchedule, mintErr error) {
+	k.Logger(ctx).Error("failed to execute mint schedule", "id", schedule.ID, "error", mintErr)
+
+	schedule.Skip(ctx.BlockHeight(), ctx.BlockTime())
+	if err := k.setMintSchedule(ctx, schedule); err != nil {
+		k.Logger(ctx).Error("failed to update mint schedule", "id", schedule.ID, "error", err)
+		k.deleteMintSchedule(ctx, schedule)
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMintScheduleFailed{
+		ScheduleID: schedule.ID,
+		Error:      mintErr.Error(),
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to emit mint schedule failed event", "id", schedule.ID, "error", err)
+	}
+}
@@ -0,0 +1,273 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestCreateMintSchedule() {
+	s.CreateDefaultDenom()
+	recipient := s.TestAccs[1]
+	amount := sdk.NewInt64Coin(s.defaultDenom, 1000)
+
+	// only the admin can create mint schedules
+	_, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[1].String(), recipient.String(), amount, 10, 0, 0, sdk.ZeroDec()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), sdk.NewInt64Coin("factory/"+s.TestAccs[0].String()+"/missing", 1000), 10, 0, 0, sdk.ZeroDec()))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), amount, 10, 0, 0, sdk.ZeroDec()))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventCreateMintSchedule{}), 1)
+	s.Require().Equal(uint64(0), res.ScheduleID)
+
+	queryRes, err := s.queryClient.MintSchedules(s.Ctx.Context(), &types.QueryMintSchedulesRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Schedules, 1)
+	s.Require().Equal(s.TestAccs[0].String(), queryRes.Schedules[0].Creator)
+	s.Require().Equal(s.Ctx.BlockHeight()+10, queryRes.Schedules[0].NextHeight)
+
+	queryRes, err = s.queryClient.MintSchedules(s.Ctx.Context(), &types.QueryMintSchedulesRequest{Denom: "factory/" + s.TestAccs[0].String() + "/other"})
+	s.Require().NoError(err)
+	s.Require().Empty(queryRes.Schedules)
+
+	queryRes, err = s.queryClient.MintSchedules(s.Ctx.Context(), &types.QueryMintSchedulesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Schedules, 1)
+
+	// an unset decay rate means no decay
+	res, err = s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), amount, 10, 0, 0, sdk.Dec{}))
+	s.Require().NoError(err)
+	schedule, found := s.App.TokenfactoryKeeper.GetMintSchedule(s.Ctx, res.ScheduleID)
+	s.Require().True(found)
+	s.Require().Equal(sdk.ZeroDec(), schedule.DecayRate)
+
+	// a frozen denom can't get new mint schedules
+	_, err = s.msgServer.GovFreezeDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgGovFreezeDenom(s.App.TokenfactoryKeeper.GetAuthority(), s.defaultDenom, true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), amount, 10, 0, 0, sdk.ZeroDec()))
+	s.Require().ErrorIs(err, types.ErrDenomFrozen)
+}
+
+func (s *KeeperTestSuite) TestExecuteMintSchedules() {
+	s.CreateDefaultDenom()
+	recipient := s.TestAccs[1]
+	startHeight := s.Ctx.BlockHeight()
+
+	// two mints every 10 blocks, halving after every mint
+	_, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 1000), 10, 0, 2, sdk.NewDecWithPrec(5, 1)))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc              string
+		height            int64
+		expectedBalance   int64
+		expectedMints     int
+		expectedSchedules int
+	}{
+		{
+			desc:              "not due before the period",
+			height:            startHeight + 9,
+			expectedBalance:   0,
+			expectedMints:     0,
+			expectedSchedules: 1,
+		},
+		{
+			desc:              "first mint",
+			height:            startHeight + 10,
+			expectedBalance:   1000,
+			expectedMints:     1,
+			expectedSchedules: 1,
+		},
+		{
+			desc:              "not due again in the same period",
+			height:            startHeight + 15,
+			expectedBalance:   1000,
+			expectedMints:     0,
+			expectedSchedules: 1,
+		},
+		{
+			desc:              "decayed last mint removes the schedule",
+			height:            startHeight + 20,
+			expectedBalance:   1500,
+			expectedMints:     1,
+			expectedSchedules: 0,
+		},
+		{
+			desc:              "no mint after the schedule ended",
+			height:            startHeight + 30,
+			expectedBalance:   1500,
+			expectedMints:     0,
+			expectedSchedules: 0,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			ctx := s.Ctx.WithBlockHeight(tc.height).WithEventManager(sdk.NewEventManager())
+			s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+
+			s.AssertEventEmitted(ctx, proto.MessageName(&types.EventScheduledMint{}), tc.expectedMints)
+			s.Require().Equal(tc.expectedBalance, s.App.BankKeeper.GetBalance(ctx, recipient, s.defaultDenom).Amount.Int64())
+			s.Require().Len(s.App.TokenfactoryKeeper.GetMintSchedules(ctx, s.defaultDenom), tc.expectedSchedules)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMintSchedulesPerDenomLimit() {
+	s.CreateDefaultDenom()
+	params := s.App.TokenfactoryKeeper.GetParams(s.Ctx)
+	params.MaxMintSchedulesPerDenom = 2
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+
+	msg := types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), s.TestAccs[1].String(), sdk.NewInt64Coin(s.defaultDenom, 100), 1, 0, 0, sdk.ZeroDec())
+	for i := 0; i < 2; i++ {
+		_, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), msg)
+		s.Require().NoError(err)
+	}
+	_, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().ErrorIs(err, types.ErrTooManyMintSchedules)
+
+	// the schedules of a denom are paginated
+	queryRes, err := s.queryClient.MintSchedules(s.Ctx.Context(), &types.QueryMintSchedulesRequest{Denom: s.defaultDenom, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Schedules, 1)
+	s.Require().Equal(uint64(0), queryRes.Schedules[0].ID)
+	s.Require().Equal(uint64(2), queryRes.Pagination.Total)
+
+	queryRes, err = s.queryClient.MintSchedules(s.Ctx.Context(), &types.QueryMintSchedulesRequest{Denom: s.defaultDenom, Pagination: &query.PageRequest{Key: queryRes.Pagination.NextKey}})
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.Schedules, 1)
+	s.Require().Equal(uint64(1), queryRes.Schedules[0].ID)
+
+	// cancelling a schedule frees a slot
+	_, err = s.msgServer.CancelMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelMintSchedule(s.TestAccs[0].String(), 0))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().NoError(err)
+
+	// zero means there is no limit
+	params.MaxMintSchedulesPerDenom = 0
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+	_, err = s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().NoError(err)
+	s.Require().Len(s.App.TokenfactoryKeeper.GetMintSchedules(s.Ctx, s.defaultDenom), 3)
+}
+
+func (s *KeeperTestSuite) TestExecuteMintSchedulesFailedMint() {
+	s.CreateDefaultDenom()
+	recipient := s.TestAccs[1]
+	startHeight := s.Ctx.BlockHeight()
+	res, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 100), 10, 0, 2, sdk.ZeroDec()))
+	s.Require().NoError(err)
+
+	// the mint fails while the recipient is not allowlisted
+	_, err = s.msgServer.SetRestricted(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetRestricted(s.TestAccs[0].String(), s.defaultDenom, true))
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithBlockHeight(startHeight + 10).WithEventManager(sdk.NewEventManager())
+	s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventMintScheduleFailed{}), 1)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventScheduledMint{}), 0)
+	s.Require().True(s.App.BankKeeper.GetBalance(ctx, recipient, s.defaultDenom).IsZero())
+
+	// the schedule skips the period without counting a mint
+	schedule, found := s.App.TokenfactoryKeeper.GetMintSchedule(ctx, res.ScheduleID)
+	s.Require().True(found)
+	s.Require().Equal(startHeight+20, schedule.NextHeight)
+	s.Require().Equal(uint64(2), schedule.RemainingMints)
+
+	// and is retried one period later
+	_, err = s.msgServer.AddToAllowlist(sdk.WrapSDKContext(s.Ctx), types.NewMsgAddToAllowlist(s.TestAccs[0].String(), s.defaultDenom, []string{recipient.String()}))
+	s.Require().NoError(err)
+	ctx = s.Ctx.WithBlockHeight(startHeight + 20).WithEventManager(sdk.NewEventManager())
+	s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventScheduledMint{}), 1)
+	s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(ctx, recipient, s.defaultDenom).Amount.Int64())
+}
+
+func (s *KeeperTestSuite) TestExecuteMintSchedulesBudget() {
+	s.CreateDefaultDenom()
+	params := s.App.TokenfactoryKeeper.GetParams(s.Ctx)
+	params.MaxMintSchedulesPerBlock = 2
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+
+	// three schedules due every block
+	for _, recipient := range s.TestAccs[:3] {
+		_, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 100), 1, 0, 0, sdk.ZeroDec()))
+		s.Require().NoError(err)
+	}
+
+	// only two schedules are visited per block, continuing where the previous block stopped
+	for i, expectedBalances := range [][]int64{
+		{100, 100, 0},
+		{200, 100, 100},
+		{200, 200, 200},
+	} {
+		ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + int64(i) + 1)
+		s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+		for j, recipient := range s.TestAccs[:3] {
+			s.Require().Equal(expectedBalances[j], s.App.BankKeeper.GetBalance(ctx, recipient, s.defaultDenom).Amount.Int64())
+		}
+	}
+
+	// a zero budget disables the execution of mint schedules
+	params.MaxMintSchedulesPerBlock = 0
+	s.App.TokenfactoryKeeper.SetParams(s.Ctx, params)
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 10)
+	s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+	s.Require().Equal(int64(200), s.App.BankKeeper.GetBalance(ctx, s.TestAccs[0], s.defaultDenom).Amount.Int64())
+}
+
+func (s *KeeperTestSuite) TestExecuteMintSchedulesAdminChanges() {
+	s.CreateDefaultDenom()
+	recipient := s.TestAccs[1]
+	_, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), recipient.String(), sdk.NewInt64Coin(s.defaultDenom, 100), 1, 0, 0, sdk.ZeroDec()))
+	s.Require().NoError(err)
+
+	// a schedule of a frozen denom is kept until the denom is unfrozen
+	_, err = s.msgServer.GovFreezeDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgGovFreezeDenom(s.App.TokenfactoryKeeper.GetAuthority(), s.defaultDenom, true))
+	s.Require().NoError(err)
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+	s.Require().True(s.App.BankKeeper.GetBalance(ctx, recipient, s.defaultDenom).IsZero())
+	s.Require().Len(s.App.TokenfactoryKeeper.GetMintSchedules(ctx, s.defaultDenom), 1)
+
+	_, err = s.msgServer.GovFreezeDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgGovFreezeDenom(s.App.TokenfactoryKeeper.GetAuthority(), s.defaultDenom, false))
+	s.Require().NoError(err)
+	s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+	s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(ctx, recipient, s.defaultDenom).Amount.Int64())
+
+	// a schedule is removed once its creator is no longer the admin
+	_, err = s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAdmin(s.TestAccs[0].String(), s.defaultDenom, s.TestAccs[2].String()))
+	s.Require().NoError(err)
+	ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 2)
+	s.App.TokenfactoryKeeper.ExecuteMintSchedules(ctx)
+	s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(ctx, recipient, s.defaultDenom).Amount.Int64())
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetMintSchedules(ctx, s.defaultDenom))
+}
+
+func (s *KeeperTestSuite) TestCancelMintSchedule() {
+	s.CreateDefaultDenom()
+	res, err := s.msgServer.CreateMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateMintSchedule(s.TestAccs[0].String(), s.TestAccs[1].String(), sdk.NewInt64Coin(s.defaultDenom, 100), 1, 0, 0, sdk.ZeroDec()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CancelMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelMintSchedule(s.TestAccs[0].String(), res.ScheduleID+1))
+	s.Require().ErrorIs(err, types.ErrMintScheduleNotFound)
+
+	// only the admin or the creator can cancel a schedule
+	_, err = s.msgServer.CancelMintSchedule(sdk.WrapSDKContext(s.Ctx), types.NewMsgCancelMintSchedule(s.TestAccs[1].String(), res.ScheduleID))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.CancelMintSchedule(sdk.WrapSDKContext(ctx), types.NewMsgCancelMintSchedule(s.TestAccs[0].String(), res.ScheduleID))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventCancelMintSchedule{}), 1)
+
+	_, found := s.App.TokenfactoryKeeper.GetMintSchedule(s.Ctx, res.ScheduleID)
+	s.Require().False(found)
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetSnapshot returns a specific snapshot of a denom
+func (k Keeper) GetSnapshot(ctx sdk.Context, denom string, id uint64) (types.Snapshot, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetSnapshotKey(id))
+	if bz == nil {
+		return types.Snapshot{}, false
+	}
+
+	snapshot := types.Snapshot{}
+	if err := proto.Unmarshal(bz, &snapshot); err != nil {
+		panic(err)
+	}
+	return snapshot, true
+}
+
+// GetSnapshots returns all the snapshots of a denom
+func (k Keeper) GetSnapshots(ctx sdk.Context, denom string) []types.Snapshot {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomSnapshotPrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	snapshots := []types.Snapshot{}
+	for ; iterator.Valid(); iterator.Next() {
+		snapshot := types.Snapshot{}
+		if err := proto.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			panic(err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// getCurrentSnapshotID returns the ID of the last snapshot of a denom, or 0 if the denom has
+// no snapshots
+func (k Keeper) getCurrentSnapshotID(ctx sdk.Context, denom string) uint64 {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomSnapshotPrefixKey)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(iterator.Key())
+}
+
+func (k Keeper) setSnapshot(ctx sdk.Context, denom string, snapshot types.Snapshot) error {
+	bz, err := proto.Marshal(&snapshot)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetSnapshotKey(snapshot.ID), bz)
+	return nil
+}
+
+// takeSnapshot records a new snapshot of the balances of denom at the current block
+func (k Keeper) takeSnapshot(ctx sdk.Context, denom string) (types.Snapshot, error) {
+	snapshot := types.Snapshot{
+		ID:     k.getCurrentSnapshotID(ctx, denom) + 1,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	}
+	err := k.setSnapshot(ctx, denom, snapshot)
+	if err != nil {
+		return types.Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// GetSnapshotCheckpoints returns the snapshot checkpoints of all the accounts of a denom
+func (k Keeper) GetSnapshotCheckpoints(ctx sdk.Context, denom string) []types.SnapshotCheckpoint {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomSnapshotCheckpointPrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	checkpoints := []types.SnapshotCheckpoint{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		addr := sdk.AccAddress(key[1 : 1+key[0]])
+
+		balance := sdk.Int{}
+		if err := balance.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		checkpoints = append(checkpoints, types.SnapshotCheckpoint{
+			Address:    addr.String(),
+			SnapshotID: sdk.BigEndianToUint64(key[1+key[0]:]),
+			Balance:    balance,
+		})
+	}
+	return checkpoints
+}
+
+func (k Keeper) setSnapshotCheckpoint(ctx sdk.Context, denom string, addr sdk.AccAddress, snapshotID uint64, balance sdk.Int) error {
+	bz, err := balance.Marshal()
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetSnapshotCheckpointKey(addr, snapshotID), bz)
+	return nil
+}
+
+// trackSnapshotBalance records the balance of addr at the current snapshot of denom, if it
+// wasn't recorded yet. It must be called before the balance changes.
+func (k Keeper) trackSnapshotBalance(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	snapshotID := k.getCurrentSnapshotID(ctx, denom)
+	if snapshotID == 0 {
+		return
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if store.Has(types.GetSnapshotCheckpointKey(addr, snapshotID)) {
+		return
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom).Amount
+	err := k.setSnapshotCheckpoint(ctx, denom, addr, snapshotID, balance)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetBalanceAtSnapshot returns the balance of addr at a snapshot of denom
+func (k Keeper) GetBalanceAtSnapshot(ctx sdk.Context, denom string, addr sdk.AccAddress, snapshotID uint64) (sdk.Int, error) {
+	if _, found := k.GetSnapshot(ctx, denom, snapshotID); !found {
+		return sdk.Int{}, types.ErrSnapshotNotFound.Wrapf("denom: %s, id: %d", denom, snapshotID)
+	}
+
+	// the first checkpoint at or after the snapshot holds the balance at the snapshot. If
+	// there is none, the balance didn't change since the snapshot.
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetSnapshotCheckpointsPrefix(addr))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(snapshotID), nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return k.bankKeeper.GetBalance(ctx, addr, denom).Amount, nil
+	}
+
+	balance := sdk.Int{}
+	if err := balance.Unmarshal(iterator.Value()); err != nil {
+		return sdk.Int{}, err
+	}
+	return balance, nil
+}
+
+// GetSnapshotHolders returns the accounts that received a denom
+func (k Keeper) GetSnapshotHolders(ctx sdk.Context, denom string) []string {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.DenomSnapshotHolderPrefixKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	holders := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		holders = append(holders, sdk.AccAddress(iterator.Key()).String())
+	}
+	return holders
+}
+
+// isSnapshotHolder returns whether addr received denom
+func (k Keeper) isSnapshotHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetSnapshotHolderKey(addr))
+}
+
+func (k Keeper) setSnapshotHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetSnapshotHolderKey(addr), []byte{})
+}
+
+// deleteSnapshots removes the snapshots of a denom, with their checkpoints and holders
+func (k Keeper) deleteSnapshots(ctx sdk.Context, denom string) {
+	denomStore := k.GetDenomPrefixStore(ctx, denom)
+	for _, prefixKey := range [][]byte{
+		types.DenomSnapshotPrefixKey,
+		types.DenomSnapshotCheckpointPrefixKey,
+		types.DenomSnapshotHolderPrefixKey,
+	} {
+		store := prefix.NewStore(denomStore, prefixKey)
+		iterator := store.Iterator(nil, nil)
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/keeper"
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestTakeSnapshot() {
+	s.CreateDefaultDenom()
+
+	// only the admin can take snapshots
+	_, err := s.msgServer.TakeSnapshot(sdk.WrapSDKContext(s.Ctx), types.NewMsgTakeSnapshot(s.TestAccs[1].String(), s.defaultDenom))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	for _, expectedID := range []uint64{1, 2} {
+		ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+		res, err := s.msgServer.TakeSnapshot(sdk.WrapSDKContext(ctx), types.NewMsgTakeSnapshot(s.TestAccs[0].String(), s.defaultDenom))
+		s.Require().NoError(err)
+		s.AssertEventEmitted(ctx, proto.MessageName(&types.EventTakeSnapshot{}), 1)
+		s.Require().Equal(expectedID, res.SnapshotID)
+
+		snapshot, found := s.App.TokenfactoryKeeper.GetSnapshot(s.Ctx, s.defaultDenom, expectedID)
+		s.Require().True(found)
+		s.Require().Equal(s.Ctx.BlockHeight(), snapshot.Height)
+	}
+}
+
+func (s *KeeperTestSuite) TestBalanceAtSnapshot() {
+	s.CreateDefaultDenom()
+	admin, holder := s.TestAccs[0], s.TestAccs[1]
+	hooks := s.App.TokenfactoryKeeper.Hooks()
+
+	// balances before the first snapshot
+	_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.TakeSnapshot(sdk.WrapSDKContext(s.Ctx), types.NewMsgTakeSnapshot(admin.String(), s.defaultDenom))
+	s.Require().NoError(err)
+
+	// balance changes between the snapshots, through the send hooks and mints
+	sent := sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 50))
+	hooks.TrackBeforeSend(s.Ctx, admin, holder, sent)
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, admin, holder, sent))
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 30), holder.String()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.TakeSnapshot(sdk.WrapSDKContext(s.Ctx), types.NewMsgTakeSnapshot(admin.String(), s.defaultDenom))
+	s.Require().NoError(err)
+
+	// balance changes after the last snapshot
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc       string
+		addr       sdk.AccAddress
+		snapshotID uint64
+		expected   int64
+	}{
+		{"admin at the first snapshot", admin, 1, 100},
+		{"holder at the first snapshot", holder, 1, 0},
+		{"admin at the second snapshot", admin, 2, 50},
+		{"holder at the second snapshot", holder, 2, 80},
+	} {
+		s.Run(tc.desc, func() {
+			res, err := s.queryClient.BalanceAtSnapshot(s.Ctx.Context(), &types.QueryBalanceAtSnapshotRequest{
+				Denom:      s.defaultDenom,
+				Address:    tc.addr.String(),
+				SnapshotId: tc.snapshotID,
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, res.Balance.Amount.Int64())
+		})
+	}
+
+	_, err = s.queryClient.BalanceAtSnapshot(s.Ctx.Context(), &types.QueryBalanceAtSnapshotRequest{
+		Denom:      s.defaultDenom,
+		Address:    admin.String(),
+		SnapshotId: 3,
+	})
+	s.Require().ErrorContains(err, types.ErrSnapshotNotFound.Error())
+
+	// the holders at a snapshot only include the accounts with a positive balance
+	holdersRes, err := s.queryClient.SnapshotHolders(s.Ctx.Context(), &types.QuerySnapshotHoldersRequest{Denom: s.defaultDenom, SnapshotId: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.SnapshotBalance{{Address: admin.String(), Balance: sdk.NewInt(100)}}, holdersRes.Holders)
+
+	holdersRes, err = s.queryClient.SnapshotHolders(s.Ctx.Context(), &types.QuerySnapshotHoldersRequest{Denom: s.defaultDenom, SnapshotId: 2})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]types.SnapshotBalance{
+		{Address: admin.String(), Balance: sdk.NewInt(50)},
+		{Address: holder.String(), Balance: sdk.NewInt(80)},
+	}, holdersRes.Holders)
+
+	holdersRes, err = s.queryClient.SnapshotHolders(s.Ctx.Context(), &types.QuerySnapshotHoldersRequest{
+		Denom:      s.defaultDenom,
+		SnapshotId: 2,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(holdersRes.Holders, 1)
+	s.Require().NotNil(holdersRes.Pagination.NextKey)
+}
+
+func (s *KeeperTestSuite) TestTrackBeforeSendSkipsModuleTransfers() {
+	s.CreateDefaultDenom()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	_, err := s.msgServer.TakeSnapshot(sdk.WrapSDKContext(s.Ctx), types.NewMsgTakeSnapshot(s.TestAccs[0].String(), s.defaultDenom))
+	s.Require().NoError(err)
+
+	// sends from the module account are tracked by the module itself
+	s.App.TokenfactoryKeeper.Hooks().TrackBeforeSend(s.Ctx, moduleAddr, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetSnapshotHolders(s.Ctx, s.defaultDenom))
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetSnapshotCheckpoints(s.Ctx, s.defaultDenom))
+
+	// sends of other denoms are not tracked
+	s.App.TokenfactoryKeeper.Hooks().TrackBeforeSend(s.Ctx, s.TestAccs[0], s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)))
+	s.Require().Empty(s.App.TokenfactoryKeeper.GetSnapshotHolders(s.Ctx, s.defaultDenom))
+}
+
+func (s *KeeperTestSuite) TestSendHooksNotRegistered() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+
+	// the app doesn't register the send hooks, so the features that depend on them can't be
+	// enabled through its keeper
+	msgServer := keeper.NewMsgServerImpl(s.App.TokenfactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := msgServer.TakeSnapshot(goCtx, types.NewMsgTakeSnapshot(admin, s.defaultDenom))
+	s.Require().ErrorIs(err, types.ErrSendHooksNotRegistered)
+	_, err = msgServer.DepositDistribution(goCtx, types.NewMsgDepositDistribution(admin, s.defaultDenom, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100))))
+	s.Require().ErrorIs(err, types.ErrSendHooksNotRegistered)
+	_, err = msgServer.SetHolderIndex(goCtx, types.NewMsgSetHolderIndex(admin, s.defaultDenom, true))
+	s.Require().ErrorIs(err, types.ErrSendHooksNotRegistered)
+	_, err = msgServer.SetTransferFee(goCtx, types.NewMsgSetTransferFee(admin, s.defaultDenom, types.TransferFee{BasisPoints: 100, Recipient: admin}))
+	s.Require().ErrorIs(err, types.ErrSendHooksNotRegistered)
+	_, err = msgServer.SetRestricted(goCtx, types.NewMsgSetRestricted(admin, s.defaultDenom, true))
+	s.Require().ErrorIs(err, types.ErrSendHooksNotRegistered)
+	_, err = msgServer.SetMaxBalance(goCtx, types.NewMsgSetMaxBalance(admin, s.defaultDenom, types.MaxBalance{Amount: sdk.NewInt(100)}))
+	s.Require().ErrorIs(err, types.ErrSendHooksNotRegistered)
+
+	// the features can still be disabled
+	_, err = msgServer.SetHolderIndex(goCtx, types.NewMsgSetHolderIndex(admin, s.defaultDenom, false))
+	s.Require().NoError(err)
+	_, err = msgServer.SetTransferFee(goCtx, types.NewMsgSetTransferFee(admin, s.defaultDenom, types.TransferFee{}))
+	s.Require().NoError(err)
+	_, err = msgServer.SetRestricted(goCtx, types.NewMsgSetRestricted(admin, s.defaultDenom, false))
+	s.Require().NoError(err)
+	_, err = msgServer.SetMaxBalance(goCtx, types.NewMsgSetMaxBalance(admin, s.defaultDenom, types.MaxBalance{Amount: sdk.ZeroInt()}))
+	s.Require().NoError(err)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetTransferFee returns the transfer fee of a specific denom, and whether the denom has
+// one
+func (k Keeper) GetTransferFee(ctx sdk.Context, denom string) (types.TransferFee, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.DenomTransferFeeKey)
+	if bz == nil {
+		return types.TransferFee{}, false
+	}
+
+	fee := types.TransferFee{}
+	if err := proto.Unmarshal(bz, &fee); err != nil {
+		panic(err)
+	}
+	return fee, true
+}
+
+// setTransferFee sets the transfer fee of a denom. A fee of zero basis points removes the
+// transfer fee of the denom.
+func (k Keeper) setTransferFee(ctx sdk.Context, denom string, fee types.TransferFee) error {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if fee.BasisPoints == 0 {
+		store.Delete(types.DenomTransferFeeKey)
+		return nil
+	}
+
+	err := fee.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&fee)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.DenomTransferFeeKey, bz)
+	return nil
+}
+
+// collectTransferFees charges the transfer fees of the factory denoms in amount to from,
+// on top of amount, and pays them to the fee recipients. The fees go through the module
+// account, so that the bank hooks aren't called again for them, and the fee recipients are
+// checked against the allowlists and the max balances of the denoms as the hooks would.
+// Transfers from or to an exempt address or the fee recipient are free.
+func (k Keeper) collectTransferFees(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		fee, found := k.GetTransferFee(ctx, coin.Denom)
+		if !found || fee.IsExempt(from) || fee.IsExempt(to) {
+			continue
+		}
+
+		recipient := sdk.MustAccAddressFromBech32(fee.Recipient)
+		if recipient.Equals(from) || recipient.Equals(to) {
+			continue
+		}
+
+		feeAmount := sdk.NewCoin(coin.Denom, fee.Amount(coin.Amount))
+		if !feeAmount.IsPositive() {
+			continue
+		}
+
+		spendable := k.bankKeeper.SpendableCoins(ctx, from).AmountOf(coin.Denom)
+		if spendable.LT(coin.Amount.Add(feeAmount.Amount)) {
+			return sdkerrors.ErrInsufficientFunds.Wrapf("%s%s is smaller than %s plus a transfer fee of %s", spendable, coin.Denom, coin, feeAmount)
+		}
+
+		err := k.checkAllowlisted(ctx, coin.Denom, recipient)
+		if err != nil {
+			return err
+		}
+		err = k.checkMaxBalance(ctx, coin.Denom, recipient, feeAmount.Amount)
+		if err != nil {
+			return err
+		}
+
+		k.trackBeforeSend(ctx, from, recipient, sdk.NewCoins(feeAmount))
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, sdk.NewCoins(feeAmount))
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(feeAmount))
+		if err != nil {
+			return err
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventCollectTransferFee{
+			Payer:     from.String(),
+			Recipient: fee.Recipient,
+			Fee:       feeAmount,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
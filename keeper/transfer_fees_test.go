@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestSetTransferFee() {
+	s.CreateDefaultDenom()
+	admin, treasury := s.TestAccs[0], s.TestAccs[1]
+	fee := types.TransferFee{BasisPoints: 100, Recipient: treasury.String()}
+
+	// only the admin can set the transfer fee
+	_, err := s.msgServer.SetTransferFee(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetTransferFee(treasury.String(), s.defaultDenom, fee))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetTransferFee(sdk.WrapSDKContext(ctx), types.NewMsgSetTransferFee(admin.String(), s.defaultDenom, fee))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventSetTransferFee{}), 1)
+
+	feeRes, err := s.queryClient.TransferFee(s.Ctx.Context(), &types.QueryTransferFeeRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(fee, feeRes.Fee)
+
+	// a fee of zero basis points removes the transfer fee
+	_, err = s.msgServer.SetTransferFee(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetTransferFee(admin.String(), s.defaultDenom, types.TransferFee{}))
+	s.Require().NoError(err)
+	_, found := s.App.TokenfactoryKeeper.GetTransferFee(s.Ctx, s.defaultDenom)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestCollectTransferFee() {
+	s.CreateDefaultDenom()
+	admin, treasury, sender := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	receiver := CreateRandomAccounts(1)[0]
+	hooks := s.App.TokenfactoryKeeper.Hooks()
+	send := func(from, to sdk.AccAddress, amount int64) error {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, amount))
+		if err := hooks.BlockBeforeSend(s.Ctx, from, to, coins); err != nil {
+			return err
+		}
+		hooks.TrackBeforeSend(s.Ctx, from, to, coins)
+		return s.App.BankKeeper.SendCoins(s.Ctx, from, to, coins)
+	}
+	balance := func(addr sdk.AccAddress) int64 {
+		return s.App.BankKeeper.GetBalance(s.Ctx, addr, s.defaultDenom).Amount.Int64()
+	}
+
+	_, err := s.msgServer.SetTransferFee(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetTransferFee(admin.String(), s.defaultDenom, types.TransferFee{
+		BasisPoints:     250,
+		Recipient:       treasury.String(),
+		ExemptAddresses: []string{admin.String()},
+	}))
+	s.Require().NoError(err)
+
+	// mints are free
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1000), sender.String()))
+	s.Require().NoError(err)
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().NoError(hooks.BlockBeforeSend(s.Ctx, moduleAddr, sender, sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 1000))))
+	s.Require().Equal(int64(1000), balance(sender))
+
+	// the fee is charged on top of the sent amount, rounded down
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Ctx = ctx
+	s.Require().NoError(send(sender, receiver, 100))
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventCollectTransferFee{}), 1)
+	s.Require().Equal(int64(898), balance(sender))
+	s.Require().Equal(int64(100), balance(receiver))
+	s.Require().Equal(int64(2), balance(treasury))
+
+	// transfers from or to exempt addresses are free
+	s.Require().NoError(send(sender, admin, 100))
+	s.Require().Equal(int64(798), balance(sender))
+	s.Require().Equal(int64(2), balance(treasury))
+
+	// the send is blocked if the sender can't pay the fee
+	s.Require().ErrorIs(send(receiver, sender, 100), sdkerrors.ErrInsufficientFunds)
+	s.Require().Equal(int64(100), balance(receiver))
+
+	// or if the fee recipient can't receive the fee, as the fee skips the bank hooks
+	_, err = s.msgServer.SetMaxBalance(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxBalance(admin.String(), s.defaultDenom, types.MaxBalance{Amount: sdk.NewInt(3), ExemptAddresses: []string{receiver.String()}}))
+	s.Require().NoError(err)
+	s.Require().ErrorIs(send(sender, receiver, 100), types.ErrMaxBalanceExceeded)
+	_, err = s.msgServer.SetMaxBalance(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxBalance(admin.String(), s.defaultDenom, types.MaxBalance{Amount: sdk.ZeroInt()}))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetRestricted(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetRestricted(admin.String(), s.defaultDenom, true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddToAllowlist(sdk.WrapSDKContext(s.Ctx), types.NewMsgAddToAllowlist(admin.String(), s.defaultDenom, []string{sender.String(), receiver.String()}))
+	s.Require().NoError(err)
+	s.Require().ErrorIs(send(sender, receiver, 100), types.ErrNotAllowlisted)
+	s.Require().Equal(int64(2), balance(treasury))
+
+	_, err = s.msgServer.AddToAllowlist(sdk.WrapSDKContext(s.Ctx), types.NewMsgAddToAllowlist(admin.String(), s.defaultDenom, []string{treasury.String()}))
+	s.Require().NoError(err)
+	s.Require().NoError(send(sender, receiver, 100))
+	s.Require().Equal(int64(4), balance(treasury))
+}
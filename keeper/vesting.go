@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// GetVestingSchedules returns the vesting schedules of a specific recipient
+func (k Keeper) GetVestingSchedules(ctx sdk.Context, recipient sdk.AccAddress) []types.VestingSchedule {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVestingSchedulesPrefix(recipient))
+	return k.iterateVestingSchedules(store)
+}
+
+// GetAllVestingSchedules returns the vesting schedules of all recipients
+func (k Keeper) GetAllVestingSchedules(ctx sdk.Context) []types.VestingSchedule {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingSchedulePrefixKey)
+	return k.iterateVestingSchedules(store)
+}
+
+func (k Keeper) iterateVestingSchedules(store prefix.Store) []types.VestingSchedule {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	schedules := []types.VestingSchedule{}
+	for ; iterator.Valid(); iterator.Next() {
+		schedule := types.VestingSchedule{}
+		if err := proto.Unmarshal(iterator.Value(), &schedule); err != nil {
+			panic(err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+func (k Keeper) setVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) error {
+	err := schedule.Validate()
+	if err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&schedule)
+	if err != nil {
+		return err
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(schedule.Recipient)
+	ctx.KVStore(k.storeKey).Set(types.GetVestingScheduleKey(recipient, schedule.ID), bz)
+	return nil
+}
+
+func (k Keeper) deleteVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	recipient := sdk.MustAccAddressFromBech32(schedule.Recipient)
+	ctx.KVStore(k.storeKey).Delete(types.GetVestingScheduleKey(recipient, schedule.ID))
+}
+
+// deleteVestingSchedules removes the vesting schedules of denom. Their unclaimed amounts are
+// part of the supply of denom, so only fully claimed schedules can be left.
+func (k Keeper) deleteVestingSchedules(ctx sdk.Context, denom string) {
+	for _, schedule := range k.GetAllVestingSchedules(ctx) {
+		if schedule.Amount.Denom == denom {
+			k.deleteVestingSchedule(ctx, schedule)
+		}
+	}
+}
+
+// GetNextVestingScheduleID returns the ID of the next vesting schedule
+func (k Keeper) GetNextVestingScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextVestingScheduleIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextVestingScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextVestingScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// mintVesting mints amount into escrow in the module account, from which it vests to
+// recipient according to a new vesting schedule.
+func (k Keeper) mintVesting(ctx sdk.Context, recipient string, amount sdk.Coin, startTime, cliffTime, endTime time.Time) (types.VestingSchedule, error) {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(amount.Denom)
+	if err != nil {
+		return types.VestingSchedule{}, err
+	}
+
+	err = k.checkAllowlisted(ctx, amount.Denom, sdk.MustAccAddressFromBech32(recipient))
+	if err != nil {
+		return types.VestingSchedule{}, err
+	}
+
+	id := k.GetNextVestingScheduleID(ctx)
+	schedule := types.VestingSchedule{
+		ID:        id,
+		Recipient: recipient,
+		Amount:    amount,
+		Claimed:   sdk.ZeroInt(),
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+	}
+	err = k.setVestingSchedule(ctx, schedule)
+	if err != nil {
+		return types.VestingSchedule{}, err
+	}
+	k.setNextVestingScheduleID(ctx, id+1)
+
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return types.VestingSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// claimVested sends the vested and not yet claimed amounts of the vesting schedules of
+// recipient from escrow to recipient. Fully claimed schedules are removed. The schedules
+// of denoms that recipient can't receive, because it isn't on the allowlist of the denom
+// or would exceed its max balance, are skipped and stay unclaimed.
+func (k Keeper) claimVested(ctx sdk.Context, recipient sdk.AccAddress) (sdk.Coins, error) {
+	schedules := k.GetVestingSchedules(ctx, recipient)
+	claimable := sdk.NewCoins()
+	for _, schedule := range schedules {
+		amount := schedule.VestedAmount(ctx.BlockTime()).Sub(schedule.Claimed)
+		if amount.IsPositive() {
+			claimable = claimable.Add(sdk.NewCoin(schedule.Amount.Denom, amount))
+		}
+	}
+
+	skipped := map[string]bool{}
+	for _, coin := range claimable {
+		if k.checkAllowlisted(ctx, coin.Denom, recipient) != nil || k.checkMaxBalance(ctx, coin.Denom, recipient, coin.Amount) != nil {
+			skipped[coin.Denom] = true
+		}
+	}
+
+	claimed := sdk.NewCoins()
+	for _, schedule := range schedules {
+		amount := schedule.VestedAmount(ctx.BlockTime()).Sub(schedule.Claimed)
+		if !amount.IsPositive() || skipped[schedule.Amount.Denom] {
+			continue
+		}
+		claimed = claimed.Add(sdk.NewCoin(schedule.Amount.Denom, amount))
+
+		schedule.Claimed = schedule.Claimed.Add(amount)
+		if schedule.Claimed.Equal(schedule.Amount.Amount) {
+			k.deleteVestingSchedule(ctx, schedule)
+			continue
+		}
+		err := k.setVestingSchedule(ctx, schedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if claimed.IsZero() {
+		return claimed, nil
+	}
+
+	k.trackBeforeSend(ctx, nil, recipient, claimed)
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, claimed)
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
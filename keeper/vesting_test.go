@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestMintVesting() {
+	s.CreateDefaultDenom()
+	recipient := s.TestAccs[1]
+	startTime := s.Ctx.BlockTime()
+	cliffTime := startTime.Add(100 * time.Hour)
+	endTime := startTime.Add(400 * time.Hour)
+	amount := sdk.NewInt64Coin(s.defaultDenom, 4000)
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	// only the admin can mint vesting tokens
+	_, err := s.msgServer.MintVesting(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintVesting(s.TestAccs[1].String(), recipient.String(), amount, startTime, cliffTime, endTime))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	res, err := s.msgServer.MintVesting(sdk.WrapSDKContext(ctx), types.NewMsgMintVesting(s.TestAccs[0].String(), recipient.String(), amount, startTime, cliffTime, endTime))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, proto.MessageName(&types.EventMintVesting{}), 1)
+	s.Require().Equal(uint64(0), res.ScheduleID)
+
+	// the minted amount is held in escrow
+	s.Require().Equal(amount, s.App.BankKeeper.GetBalance(s.Ctx, moduleAddr, s.defaultDenom))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, recipient, s.defaultDenom).IsZero())
+
+	for _, tc := range []struct {
+		desc              string
+		blockTime         time.Time
+		expectedVested    int64
+		expectedClaimed   int64
+		expectedSchedules int
+	}{
+		{
+			desc:              "nothing vests before the cliff",
+			blockTime:         cliffTime.Add(-time.Second),
+			expectedVested:    0,
+			expectedClaimed:   0,
+			expectedSchedules: 1,
+		},
+		{
+			desc:              "linear vesting from the start time after the cliff",
+			blockTime:         startTime.Add(200 * time.Hour),
+			expectedVested:    2000,
+			expectedClaimed:   2000,
+			expectedSchedules: 1,
+		},
+		{
+			desc:              "partially claimed schedule",
+			blockTime:         startTime.Add(300 * time.Hour),
+			expectedVested:    3000,
+			expectedClaimed:   1000,
+			expectedSchedules: 1,
+		},
+		{
+			desc:              "fully claimed schedule is removed",
+			blockTime:         endTime.Add(time.Hour),
+			expectedVested:    4000,
+			expectedClaimed:   1000,
+			expectedSchedules: 0,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.Ctx = s.Ctx.WithBlockTime(tc.blockTime)
+
+			queryRes, err := s.App.TokenfactoryKeeper.VestingSchedules(sdk.WrapSDKContext(s.Ctx), &types.QueryVestingSchedulesRequest{Recipient: recipient.String()})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedVested, queryRes.Vested.AmountOf(s.defaultDenom).Int64())
+			s.Require().Equal(amount.Amount.Int64()-tc.expectedVested, queryRes.Unvested.AmountOf(s.defaultDenom).Int64())
+			s.Require().Equal(tc.expectedClaimed, queryRes.Claimable.AmountOf(s.defaultDenom).Int64())
+
+			balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, recipient, s.defaultDenom)
+			claimRes, err := s.msgServer.ClaimVested(sdk.WrapSDKContext(s.Ctx), types.NewMsgClaimVested(recipient.String()))
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedClaimed, claimRes.Claimed.AmountOf(s.defaultDenom).Int64())
+
+			balanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, recipient, s.defaultDenom)
+			s.Require().Equal(tc.expectedClaimed, balanceAfter.Sub(balanceBefore).Amount.Int64())
+			s.Require().Len(s.App.TokenfactoryKeeper.GetVestingSchedules(s.Ctx, recipient), tc.expectedSchedules)
+		})
+	}
+
+	// the escrow is empty once everything was claimed
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, moduleAddr, s.defaultDenom).IsZero())
+	s.Require().Equal(amount, s.App.BankKeeper.GetBalance(s.Ctx, recipient, s.defaultDenom))
+}
+
+func (s *KeeperTestSuite) TestVestingEscrowCannotBeDrained() {
+	s.CreateDefaultDenom()
+	admin := s.TestAccs[0].String()
+	amount := sdk.NewInt64Coin(s.defaultDenom, 4000)
+	moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	distrAddr := s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.msgServer.MintVesting(goCtx, types.NewMsgMintVesting(admin, s.TestAccs[1].String(), amount, s.Ctx.BlockTime(), s.Ctx.BlockTime(), s.Ctx.BlockTime().Add(time.Hour)))
+	s.Require().NoError(err)
+
+	// the admin can't burn or force transfer the escrow from the module account
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurnFrom(admin, amount, moduleAddr.String()))
+	s.Require().ErrorIs(err, types.ErrBurnFromModuleAccount)
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(admin, amount, moduleAddr.String(), admin))
+	s.Require().ErrorIs(err, types.ErrForceTransferModuleAccount)
+	s.Require().Equal(amount, s.App.BankKeeper.GetBalance(s.Ctx, moduleAddr, s.defaultDenom))
+
+	// nor from or to any other module account
+	_, err = s.msgServer.Mint(goCtx, types.NewMsgMint(admin, amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ForceTransfer(goCtx, types.NewMsgForceTransfer(admin, amount, admin, distrAddr.String()))
+	s.Require().ErrorIs(err, types.ErrForceTransferModuleAccount)
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurnFrom(admin, amount, distrAddr.String()))
+	s.Require().ErrorIs(err, types.ErrBurnFromModuleAccount)
+
+	// the burn from address is parsed as a bech32 address
+	_, err = s.msgServer.Burn(goCtx, types.NewMsgBurnFrom(admin, amount, "invalid"))
+	s.Require().Error(err)
+}
+
+// TestClaimVestedSkipsBlockedDenoms tests that a denom that the recipient can't receive
+// doesn't block the claims of the other denoms
+func (s *KeeperTestSuite) TestClaimVestedSkipsBlockedDenoms() {
+	s.CreateDefaultDenom()
+	admin, recipient := s.TestAccs[0], s.TestAccs[1]
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	res, err := s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(admin.String(), "restricted"))
+	s.Require().NoError(err)
+	restrictedDenom := res.GetNewTokenDenom()
+	res, err = s.msgServer.CreateDenom(goCtx, types.NewMsgCreateDenom(admin.String(), "capped"))
+	s.Require().NoError(err)
+	cappedDenom := res.GetNewTokenDenom()
+
+	endTime := s.Ctx.BlockTime().Add(time.Hour)
+	for _, denom := range []string{s.defaultDenom, restrictedDenom, cappedDenom} {
+		_, err = s.msgServer.MintVesting(goCtx, types.NewMsgMintVesting(admin.String(), recipient.String(), sdk.NewInt64Coin(denom, 100), s.Ctx.BlockTime(), s.Ctx.BlockTime(), endTime))
+		s.Require().NoError(err)
+	}
+	_, err = s.msgServer.SetRestricted(goCtx, types.NewMsgSetRestricted(admin.String(), restrictedDenom, true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetMaxBalance(goCtx, types.NewMsgSetMaxBalance(admin.String(), cappedDenom, types.MaxBalance{Amount: sdk.NewInt(50)}))
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(endTime)
+	claimRes, err := s.msgServer.ClaimVested(sdk.WrapSDKContext(s.Ctx), types.NewMsgClaimVested(recipient.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.defaultDenom, 100)), claimRes.Claimed)
+
+	// the skipped schedules stay unclaimed until the recipient can receive the denom
+	schedules := s.App.TokenfactoryKeeper.GetVestingSchedules(s.Ctx, recipient)
+	s.Require().Len(schedules, 2)
+	for _, schedule := range schedules {
+		s.Require().True(schedule.Claimed.IsZero())
+	}
+
+	_, err = s.msgServer.AddToAllowlist(goCtx, types.NewMsgAddToAllowlist(admin.String(), restrictedDenom, []string{recipient.String()}))
+	s.Require().NoError(err)
+	claimRes, err = s.msgServer.ClaimVested(sdk.WrapSDKContext(s.Ctx), types.NewMsgClaimVested(recipient.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(restrictedDenom, 100)), claimRes.Claimed)
+	s.Require().Len(s.App.TokenfactoryKeeper.GetVestingSchedules(s.Ctx, recipient), 1)
+}
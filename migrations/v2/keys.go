@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"strings"
+)
+
+// Store keys of the v1 layout, which joined the parts of every key with "|".
+//
+// - denoms|{denom}|authoritymetadata: DenomAuthorityMetadata
+// - creator|{creator}|{denom}: denom
+const (
+	KeySeparator = "|"
+
+	DenomAuthorityMetadataKey = "authoritymetadata"
+	DenomsPrefixKey           = "denoms"
+	CreatorPrefixKey          = "creator"
+)
+
+// GetDenomPrefixStore returns the v1 store prefix of a specific denom
+func GetDenomPrefixStore(denom string) []byte {
+	return []byte(strings.Join([]string{DenomsPrefixKey, denom, ""}, KeySeparator))
+}
+
+// GetCreatorPrefix returns the v1 store prefix of the denoms of a specific creator
+func GetCreatorPrefix(creator string) []byte {
+	return []byte(strings.Join([]string{CreatorPrefixKey, creator, ""}, KeySeparator))
+}
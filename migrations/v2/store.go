@@ -0,0 +1,95 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration rewrites all keys from the "|" separated string layout to the
+// binary layout with single byte prefixes and length-prefixed denoms and
+// creator addresses. Values don't change.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateDenomKeys(store); err != nil {
+		return err
+	}
+
+	return migrateCreatorKeys(store)
+}
+
+// migrateDenomKeys migrates the keys of the denom prefix stores.
+// old key is of format:
+// "denoms|" || denom || "|" || "authoritymetadata"
+// new key is of format:
+// 0x01 || len(denom) || denom || 0x01
+func migrateDenomKeys(store sdk.KVStore) error {
+	oldStore := prefix.NewStore(store, []byte(DenomsPrefixKey+KeySeparator))
+
+	for _, pair := range getPairs(oldStore) {
+		// denoms can't contain the separator, so the first one ends the denom
+		denom, key, found := strings.Cut(string(pair.Key), KeySeparator)
+		if !found {
+			return fmt.Errorf("invalid v1 denom store key: %s", pair.Key)
+		}
+
+		var newKey []byte
+		switch key {
+		case DenomAuthorityMetadataKey:
+			newKey = types.DenomAuthorityMetadataKey
+		default:
+			return fmt.Errorf("unknown v1 denom store key: %s", pair.Key)
+		}
+
+		store.Set(append(types.GetDenomPrefixStore(denom), newKey...), pair.Value)
+		oldStore.Delete(pair.Key)
+	}
+
+	return nil
+}
+
+// migrateCreatorKeys migrates the keys of the creator prefix stores.
+// old key is of format:
+// "creator|" || creator (bech32) || "|" || denom
+// new key is of format:
+// 0x02 || len(creatorAddr) || creatorAddr || denom
+func migrateCreatorKeys(store sdk.KVStore) error {
+	oldStore := prefix.NewStore(store, []byte(CreatorPrefixKey+KeySeparator))
+
+	for _, pair := range getPairs(oldStore) {
+		creator, denom, found := strings.Cut(string(pair.Key), KeySeparator)
+		if !found {
+			return fmt.Errorf("invalid v1 creator store key: %s", pair.Key)
+		}
+
+		creatorAddr, err := sdk.AccAddressFromBech32(creator)
+		if err != nil {
+			return err
+		}
+
+		store.Set(append(types.GetCreatorPrefix(creatorAddr), denom...), pair.Value)
+		oldStore.Delete(pair.Key)
+	}
+
+	return nil
+}
+
+// getPairs returns all the key-value pairs of a store. The migrations rewrite the keys
+// they iterate over, so the iterator is closed before any key is written or deleted.
+func getPairs(store sdk.KVStore) []kv.Pair {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pairs := []kv.Pair{}
+	for ; iterator.Valid(); iterator.Next() {
+		pairs = append(pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+	}
+	return pairs
+}
@@ -0,0 +1,46 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// migration adds a DenomCreationRecord for every existing denom. The creation
+// height, time and fee of denoms created before v3 are unknown, so their
+// records only contain the creator.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	creatorsStore := prefix.NewStore(store, types.GetCreatorsPrefix())
+	iterator := creatorsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Value())
+
+		creator, _, err := types.DeconstructDenom(denom)
+		if err != nil {
+			return err
+		}
+
+		record := types.DenomCreationRecord{
+			Creator:     creator,
+			CreationFee: sdk.NewCoins(),
+		}
+		bz, err := proto.Marshal(&record)
+		if err != nil {
+			return err
+		}
+
+		denomStore := prefix.NewStore(store, types.GetDenomPrefixStore(denom))
+		if !denomStore.Has(types.DenomCreationRecordKey) {
+			denomStore.Set(types.DenomCreationRecordKey, bz)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v4
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// BankKeeper defines the bank keeper methods used by the migration.
+type BankKeeper interface {
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+}
+
+// MigrateParams performs in-place params migrations from v3 to v4. The
+// migration adds the ReservedSubdenoms param, which replaces the check that
+// rejected subdenoms with the same name as a native denom. The list is seeded
+// with the native denoms that have a supply, so that they stay protected after
+// the upgrade. The ReservedSubdenomExemptCreators param starts empty.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace, bankKeeper BankKeeper) error {
+	if !paramSpace.Has(ctx, types.KeyReservedSubdenomExemptCreators) {
+		paramSpace.Set(ctx, types.KeyReservedSubdenomExemptCreators, []string{})
+	}
+	if paramSpace.Has(ctx, types.KeyReservedSubdenoms) {
+		return nil
+	}
+
+	reservedSubdenoms := []string{}
+	bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		// longer denoms can't be used as a subdenom
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") && len(coin.Denom) <= types.MaxSubdenomLength {
+			reservedSubdenoms = append(reservedSubdenoms, coin.Denom)
+		}
+		return false
+	})
+
+	paramSpace.Set(ctx, types.KeyReservedSubdenoms, reservedSubdenoms)
+	return nil
+}
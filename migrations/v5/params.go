@@ -0,0 +1,28 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// MigrateParams performs in-place params migrations from v4 to v5. The
+// migration adds the MaxDenomsPerCreator, MaxCreationsPerWindow and
+// CreationWindowBlocks params with their defaults, which don't limit denom
+// creation.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+
+	if !paramSpace.Has(ctx, types.KeyMaxDenomsPerCreator) {
+		paramSpace.Set(ctx, types.KeyMaxDenomsPerCreator, defaultParams.MaxDenomsPerCreator)
+	}
+	if !paramSpace.Has(ctx, types.KeyMaxCreationsPerWindow) {
+		paramSpace.Set(ctx, types.KeyMaxCreationsPerWindow, defaultParams.MaxCreationsPerWindow)
+	}
+	if !paramSpace.Has(ctx, types.KeyCreationWindowBlocks) {
+		paramSpace.Set(ctx, types.KeyCreationWindowBlocks, defaultParams.CreationWindowBlocks)
+	}
+
+	return nil
+}
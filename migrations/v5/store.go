@@ -0,0 +1,41 @@
+package v5
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The
+// migration stores the number of denoms of each creator, which the
+// MaxDenomsPerCreator limit is checked against.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// the denoms of a creator are stored next to each other, as their keys are
+	// prefixed with the length prefixed creator address
+	creators := [][]byte{}
+	counts := []uint64{}
+
+	iterator := prefix.NewStore(store, types.GetCreatorsPrefix()).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		creator := key[1 : 1+int(key[0])]
+
+		if len(creators) == 0 || !bytes.Equal(creators[len(creators)-1], creator) {
+			creators = append(creators, creator)
+			counts = append(counts, 0)
+		}
+		counts[len(counts)-1]++
+	}
+	iterator.Close()
+
+	for i, creator := range creators {
+		store.Set(types.GetCreatorDenomCountKey(creator), sdk.Uint64ToBigEndian(counts[i]))
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package v6
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// MigrateParams performs in-place params migrations from v5 to v6. The
+// migration adds the DenomCreationFeeIsDeposit param, disabled, so that the
+// denom creation fee keeps being transferred to the community pool.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyDenomCreationFeeIsDeposit) {
+		paramSpace.Set(ctx, types.KeyDenomCreationFeeIsDeposit, types.DefaultParams().DenomCreationFeeIsDeposit)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package v7
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// MigrateParams performs in-place params migrations from v6 to v7. The
+// migration adds the DenomUnitPatterns and ReservedSymbols params, both empty,
+// so that the denom units of metadata set from now on must be namespaced under
+// the base denom.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+
+	if !paramSpace.Has(ctx, types.KeyDenomUnitPatterns) {
+		paramSpace.Set(ctx, types.KeyDenomUnitPatterns, defaultParams.DenomUnitPatterns)
+	}
+	if !paramSpace.Has(ctx, types.KeyReservedSymbols) {
+		paramSpace.Set(ctx, types.KeyReservedSymbols, defaultParams.ReservedSymbols)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package v8
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// MigrateParams performs in-place params migrations from v7 to v8. The
+// migration adds the MaxMintSchedulesPerBlock and MaxMintSchedulesPerDenom
+// params with their default values.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+
+	if !paramSpace.Has(ctx, types.KeyMaxMintSchedulesPerBlock) {
+		paramSpace.Set(ctx, types.KeyMaxMintSchedulesPerBlock, defaultParams.MaxMintSchedulesPerBlock)
+	}
+	if !paramSpace.Has(ctx, types.KeyMaxMintSchedulesPerDenom) {
+		paramSpace.Set(ctx, types.KeyMaxMintSchedulesPerDenom, defaultParams.MaxMintSchedulesPerDenom)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package v9
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// BankKeeper defines the bank keeper methods used by the migration.
+type BankKeeper interface {
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+}
+
+// MigrateStore performs in-place store migrations from v8 to v9. The
+// migration records the accounts holding a factory denom as its snapshot
+// holders, so that the holders at future snapshots include the accounts that
+// received the denom before v9. The bank module of this SDK version doesn't
+// index balances by denom, so the balances of all denoms are iterated, but only
+// the factory denoms are looked up in the store.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, bankKeeper BankKeeper) error {
+	store := ctx.KVStore(storeKey)
+
+	bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if !coin.IsPositive() || !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			return false
+		}
+
+		denomStore := prefix.NewStore(store, types.GetDenomPrefixStore(coin.Denom))
+		if denomStore.Has(types.DenomCreationRecordKey) {
+			denomStore.Set(types.GetSnapshotHolderKey(addr), []byte{})
+		}
+		return false
+	})
+
+	return nil
+}
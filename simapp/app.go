@@ -88,6 +88,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/osmosis-labs/tokenfactory"
+	tokenfactoryclient "github.com/osmosis-labs/tokenfactory/client"
 	tokenfactorykeeper "github.com/osmosis-labs/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/tokenfactory/types"
 
@@ -114,6 +115,8 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			tokenfactoryclient.UpdateReservedSubdenomsProposalHandler, tokenfactoryclient.DelistDenomProposalHandler,
+			tokenfactoryclient.SetDenomAdminProposalHandler, tokenfactoryclient.FreezeDenomProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -286,12 +289,24 @@ func NewSimApp(
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
+	// the tokenfactory authority is the gov module account, which executes the tokenfactory proposals
+	app.TokenfactoryKeeper = tokenfactorykeeper.NewKeeper(
+		keys[tokenfactorytypes.StoreKey], app.GetSubspace(tokenfactorytypes.ModuleName), app.AccountKeeper,
+		app.BankKeeper, app.DistrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the bank module of this SDK version has no send hooks, so the tokenfactory hooks are not
+	// registered and the features that depend on them stay disabled. Apps with a bank module
+	// that supports them register them here:
+	//
+	//	app.BankKeeper.SetHooks(app.TokenfactoryKeeper.Hooks())
+	//	app.TokenfactoryKeeper.SetSendHooksRegistered()
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, tokenfactorykeeper.NewParamChangeProposalHandler(app.TokenfactoryKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(tokenfactorytypes.RouterKey, tokenfactorykeeper.NewProposalHandler(app.TokenfactoryKeeper))
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -310,11 +325,6 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	tokenfactoryKeeper := tokenfactorykeeper.NewKeeper(
-		keys[tokenfactorytypes.StoreKey], app.GetSubspace(tokenfactorytypes.ModuleName), app.AccountKeeper,
-		app.BankKeeper, app.DistrKeeper)
-
-	app.TokenfactoryKeeper = tokenfactoryKeeper
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
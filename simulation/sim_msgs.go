@@ -271,7 +271,7 @@ func randomSimAccount(r *rand.Rand, accs []simtypes.Account) simtypes.Account {
 
 func accountCreatedTokenFactoryDenom(k keeper.Keeper, ctx sdk.Context) SimAccountConstraint {
 	return func(acc simtypes.Account) bool {
-		store := k.GetCreatorPrefixStore(ctx, acc.Address.String())
+		store := k.GetCreatorPrefixStore(ctx, acc.Address)
 		iterator := store.Iterator(nil, nil)
 		defer iterator.Close()
 		return iterator.Valid()
@@ -279,7 +279,7 @@ func accountCreatedTokenFactoryDenom(k keeper.Keeper, ctx sdk.Context) SimAccoun
 }
 
 func getTokenFactoryDenomAndItsAdmin(k keeper.Keeper, r *rand.Rand, ctx sdk.Context, acc simtypes.Account) (string, sdk.AccAddress, error) {
-	store := k.GetCreatorPrefixStore(ctx, acc.Address.String())
+	store := k.GetCreatorPrefixStore(ctx, acc.Address)
 	denoms := gatherAllKeysFromStore(store)
 	denom := randSelect(r, denoms...)
 
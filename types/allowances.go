@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateAllowance checks the owner, spender and amount of an allowance
+func ValidateAllowance(owner, spender string, amount sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAllowance, "invalid owner address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(spender); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAllowance, "invalid spender address (%s)", err)
+	}
+	if owner == spender {
+		return errorsmod.Wrapf(ErrInvalidAllowance, "owner %s can't be its own spender", owner)
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidAllowance, "invalid amount %s", amount)
+	}
+	if _, _, err := DeconstructDenom(amount.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (allowance Allowance) Validate() error {
+	return ValidateAllowance(allowance.Owner, allowance.Spender, allowance.Amount)
+}
+
+// IsExpired returns whether the allowance can't be used anymore at a given time
+func (allowance Allowance) IsExpired(t time.Time) bool {
+	return allowance.Expiration != nil && !t.Before(*allowance.Expiration)
+}
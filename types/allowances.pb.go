@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/v1beta1/allowances.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Allowance allows a spender to transfer up to an amount of a factory denom
+// from the balance of its owner, until its optional expiration.
+type Allowance struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty" yaml:"spender"`
+	// amount is the remaining amount the spender can transfer.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	// expiration is the time from which the allowance can't be used anymore. It
+	// is optional.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" yaml:"expiration"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64ca93815c87991, []int{0}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
+func (m *Allowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Allowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *Allowance) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Allowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Allowance)(nil), "tokenfactory.v1beta1.Allowance")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/v1beta1/allowances.proto", fileDescriptor_d64ca93815c87991)
+}
+
+var fileDescriptor_d64ca93815c87991 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x4f, 0xe3, 0x30,
+	0x18, 0xc6, 0xe3, 0x5e, 0xaf, 0xa7, 0xe6, 0xfe, 0xe8, 0x2e, 0xea, 0x49, 0x69, 0x87, 0xb8, 0x8a,
+	0x74, 0xa7, 0xea, 0x74, 0xd8, 0x2a, 0x6c, 0xdd, 0x08, 0x0c, 0xcc, 0x11, 0x2c, 0x6c, 0x4e, 0x70,
+	0x43, 0x44, 0xe2, 0x37, 0x8a, 0x5d, 0x4a, 0xbf, 0x01, 0x63, 0x3f, 0x02, 0x1f, 0xa7, 0x63, 0x47,
+	0xa6, 0x80, 0xda, 0x85, 0x39, 0x9f, 0x00, 0x35, 0x4e, 0xa0, 0x6c, 0xb6, 0xdf, 0xdf, 0xf3, 0xf8,
+	0xf1, 0x63, 0xf3, 0x8f, 0x82, 0x1b, 0x2e, 0xa6, 0x2c, 0x54, 0x90, 0x2f, 0xe8, 0xed, 0x38, 0xe0,
+	0x8a, 0x8d, 0x29, 0x4b, 0x12, 0x98, 0x33, 0x11, 0x72, 0x49, 0xb2, 0x1c, 0x14, 0x58, 0xbd, 0x7d,
+	0x8c, 0xd4, 0xd8, 0xa0, 0x17, 0x41, 0x04, 0x15, 0x40, 0x77, 0x2b, 0xcd, 0x0e, 0x70, 0x04, 0x10,
+	0x25, 0x9c, 0x56, 0xbb, 0x60, 0x36, 0xa5, 0x2a, 0x4e, 0xb9, 0x54, 0x2c, 0xcd, 0x6a, 0xc0, 0x09,
+	0x41, 0xa6, 0x20, 0x69, 0xc0, 0x24, 0x7f, 0xbb, 0x32, 0x84, 0x58, 0xe8, 0xb9, 0x7b, 0xdf, 0x32,
+	0xbb, 0xc7, 0x4d, 0x02, 0xeb, 0xaf, 0xf9, 0x19, 0xe6, 0x82, 0xe7, 0x36, 0x1a, 0xa2, 0x51, 0xd7,
+	0xfb, 0x59, 0x16, 0xf8, 0xdb, 0x82, 0xa5, 0xc9, 0xc4, 0xad, 0x8e, 0x5d, 0x5f, 0x8f, 0xad, 0xff,
+	0xe6, 0x17, 0x99, 0x71, 0x71, 0xc5, 0x73, 0xbb, 0x55, 0x91, 0x56, 0x59, 0xe0, 0x1f, 0x9a, 0xac,
+	0x07, 0xae, 0xdf, 0x20, 0xd6, 0x99, 0xd9, 0x61, 0x29, 0xcc, 0x84, 0xb2, 0x3f, 0x0d, 0xd1, 0xe8,
+	0xeb, 0x61, 0x9f, 0xe8, 0x50, 0x64, 0x17, 0xaa, 0x79, 0x20, 0x39, 0x81, 0x58, 0x78, 0xbf, 0x57,
+	0x05, 0x36, 0xca, 0x02, 0x7f, 0xd7, 0x5e, 0x5a, 0xe6, 0xfa, 0xb5, 0xde, 0xba, 0x30, 0x4d, 0x7e,
+	0x97, 0xc5, 0x39, 0x53, 0x31, 0x08, 0xbb, 0x5d, 0xb9, 0x0d, 0x88, 0xee, 0x80, 0x34, 0x1d, 0x90,
+	0xf3, 0xa6, 0x03, 0xaf, 0x5f, 0x16, 0xf8, 0x97, 0xb6, 0x7a, 0xd7, 0xb9, 0xcb, 0x27, 0x8c, 0xfc,
+	0x3d, 0xa3, 0x49, 0xfb, 0xe5, 0x01, 0x23, 0xef, 0x74, 0xb5, 0x71, 0xd0, 0x7a, 0xe3, 0xa0, 0xe7,
+	0x8d, 0x83, 0x96, 0x5b, 0xc7, 0x58, 0x6f, 0x1d, 0xe3, 0x71, 0xeb, 0x18, 0x97, 0xff, 0xa2, 0x58,
+	0x5d, 0xcf, 0x02, 0x12, 0x42, 0x4a, 0xab, 0xe4, 0xb1, 0x3c, 0x48, 0x58, 0x20, 0xe9, 0x87, 0x0f,
+	0x55, 0x8b, 0x8c, 0xcb, 0xa0, 0x53, 0xc5, 0x38, 0x7a, 0x1d, 0x00, 0x65, 0x31, 0xb7, 0x22, 0xed,
+	0x01, 0x00, 0x00,
+}
+
+func (this *Allowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Allowance)
+	if !ok {
+		that2, ok := that.(Allowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Spender != that1.Spender {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
+	return true
+}
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAllowances(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAllowances(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintAllowances(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAllowances(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAllowances(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAllowances(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAllowances(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovAllowances(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAllowances(uint64(l))
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAllowances(uint64(l))
+	}
+	return n
+}
+
+func sovAllowances(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAllowances(x uint64) (n int) {
+	return sovAllowances(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowances
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowances
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowances
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowances
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowances
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowances(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowances
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAllowances(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAllowances
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowances
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowances
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAllowances
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAllowances
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAllowances
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAllowances        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAllowances          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAllowances = fmt.Errorf("proto: unexpected end of group")
+)
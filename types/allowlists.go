@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxAllowlistAddressesPerMsg is the highest number of addresses added to or removed from
+// an allowlist by a single message
+const MaxAllowlistAddressesPerMsg = 100
+
+// ValidateAllowlist checks that the addresses of an allowlist are valid and unique
+func ValidateAllowlist(addresses []string) error {
+	seen := map[string]bool{}
+	for _, addr := range addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid allowlist address (%s)", err)
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowlist address %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// validateAllowlistUpdate checks the addresses added to or removed from an allowlist by a
+// message
+func validateAllowlistUpdate(addresses []string) error {
+	if len(addresses) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no allowlist addresses to update")
+	}
+	if len(addresses) > MaxAllowlistAddressesPerMsg {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%d allowlist addresses exceed the maximum of %d per message", len(addresses), MaxAllowlistAddressesPerMsg)
+	}
+	return ValidateAllowlist(addresses)
+}
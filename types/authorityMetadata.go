@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +13,9 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	if _, ok := MetadataLock_name[int32(metadata.MetadataLock)]; !ok {
+		return fmt.Errorf("invalid metadata lock: %d", metadata.MetadataLock)
+	}
 	return nil
 }
@@ -24,12 +24,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MetadataLock is the lock state of the bank metadata of a denom.
+type MetadataLock int32
+
+const (
+	// METADATA_LOCK_NONE allows the admin to change all the metadata.
+	MetadataLockNone MetadataLock = 0
+	// METADATA_LOCK_PARTIAL only allows the admin to change the description.
+	MetadataLockPartial MetadataLock = 1
+	// METADATA_LOCK_FULL doesn't allow the admin to change the metadata.
+	MetadataLockFull MetadataLock = 2
+)
+
+var MetadataLock_name = map[int32]string{
+	0: "METADATA_LOCK_NONE",
+	1: "METADATA_LOCK_PARTIAL",
+	2: "METADATA_LOCK_FULL",
+}
+
+var MetadataLock_value = map[string]int32{
+	"METADATA_LOCK_NONE":    0,
+	"METADATA_LOCK_PARTIAL": 1,
+	"METADATA_LOCK_FULL":    2,
+}
+
+func (x MetadataLock) String() string {
+	return proto.EnumName(MetadataLock_name, int32(x))
+}
+
+func (MetadataLock) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b00b40c54827026, []int{0}
+}
+
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
 // capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// permission, but is planned to be extended to the future. Governance can
+// freeze a denom, which disables all admin actions until it is unfrozen. The
+// admin can lock the bank metadata of a denom, which can't be undone.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// frozen is set when governance froze the denom.
+	Frozen bool `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+	// metadata_lock is the lock state of the bank metadata of the denom.
+	MetadataLock MetadataLock `protobuf:"varint,3,opt,name=metadata_lock,json=metadataLock,proto3,enum=tokenfactory.v1beta1.MetadataLock" json:"metadata_lock,omitempty" yaml:"metadata_lock"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,7 +110,22 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataLock() MetadataLock {
+	if m != nil {
+		return m.MetadataLock
+	}
+	return MetadataLockNone
+}
+
 func init() {
+	proto.RegisterEnum("tokenfactory.v1beta1.MetadataLock", MetadataLock_name, MetadataLock_value)
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "tokenfactory.v1beta1.DenomAuthorityMetadata")
 }
 
@@ -81,22 +134,32 @@ func init() {
 }
 
 var fileDescriptor_1b00b40c54827026 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49,
-	0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x41, 0x56, 0xad, 0x07, 0x55, 0x2d,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa0, 0x0f, 0x62, 0x41, 0xd4, 0x4a, 0xc9, 0x25, 0xe7,
-	0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x27, 0x25, 0x16, 0xa7, 0xc2, 0x0d, 0x4e, 0xce, 0xcf, 0xcc, 0x83,
-	0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6, 0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x25, 0xa4, 0xc6,
-	0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9,
-	0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5,
-	0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xcb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0x69, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0xdd, 0x92,
-	0x59, 0xac, 0x9b, 0x93, 0x98, 0x54, 0xac, 0x8f, 0xe2, 0xe7, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
-	0x36, 0xb0, 0xa3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x06, 0xaa, 0xc8, 0x10, 0x01,
-	0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0x87, 0x33, 0xeb, 0xba, 0xe8, 0xd0, 0x95, 0x18, 0xa3, 0x86, 0x1c, 0x26, 0x21, 0x07, 0xa9,
+	0xcb, 0x9a, 0xb0, 0xeb, 0x6d, 0x6f, 0x89, 0x6d, 0x41, 0x4c, 0xff, 0x10, 0xea, 0xc5, 0x4b, 0x99,
+	0xa4, 0xd3, 0x36, 0x34, 0xc9, 0x5b, 0x92, 0xa9, 0x10, 0x3f, 0x81, 0xf4, 0xe4, 0x17, 0x28, 0x08,
+	0xfa, 0x61, 0x3c, 0xf6, 0xe0, 0xc1, 0x53, 0x91, 0xf6, 0xe2, 0xb9, 0x9f, 0x40, 0x4c, 0xaa, 0xa4,
+	0x76, 0x6f, 0xc3, 0xfb, 0x3e, 0xef, 0xf3, 0x9b, 0xe1, 0x1d, 0x7c, 0xc9, 0x61, 0xca, 0x92, 0x11,
+	0x0d, 0x38, 0xa4, 0xb9, 0xf5, 0xfe, 0xca, 0x67, 0x9c, 0x5e, 0x59, 0x74, 0xce, 0x27, 0x90, 0x86,
+	0x3c, 0x6f, 0x33, 0x4e, 0x87, 0x94, 0x53, 0x73, 0x96, 0x02, 0x07, 0x49, 0xae, 0xd2, 0xe6, 0x9e,
+	0x56, 0xe5, 0x31, 0x8c, 0xa1, 0x00, 0xac, 0x3f, 0xa7, 0x92, 0x55, 0x49, 0x00, 0x59, 0x0c, 0x99,
+	0xe5, 0xd3, 0x8c, 0xfd, 0x13, 0x07, 0x10, 0x26, 0x65, 0xdf, 0xf8, 0x8e, 0xf0, 0x93, 0x06, 0x4b,
+	0x20, 0xb6, 0xff, 0x0f, 0x93, 0x9e, 0xe1, 0xbb, 0x74, 0x18, 0x87, 0x89, 0x82, 0x74, 0x54, 0xbf,
+	0xef, 0x88, 0xbb, 0xb5, 0x56, 0xcb, 0x69, 0x1c, 0xdd, 0x18, 0x45, 0xd9, 0xf0, 0xca, 0xb6, 0xf4,
+	0x1c, 0x9f, 0x8d, 0x52, 0xf8, 0xc0, 0x12, 0xe5, 0x44, 0x47, 0xf5, 0x7b, 0xce, 0xc3, 0xdd, 0x5a,
+	0x3b, 0x2f, 0xc1, 0xb2, 0x6e, 0x78, 0x7b, 0x40, 0xa2, 0xf8, 0x3c, 0xde, 0xeb, 0x07, 0x11, 0x04,
+	0x53, 0xe5, 0x8e, 0x8e, 0xea, 0x0f, 0xae, 0x0d, 0xf3, 0xb6, 0x17, 0x99, 0x7f, 0x6f, 0xe2, 0x42,
+	0x30, 0x75, 0x94, 0xdd, 0x5a, 0x93, 0x4b, 0xeb, 0x81, 0xc2, 0xf0, 0x6a, 0x71, 0x85, 0xbb, 0x39,
+	0xfd, 0xf5, 0x59, 0x43, 0x17, 0x5f, 0x11, 0xae, 0x55, 0xc7, 0xa5, 0x4b, 0x2c, 0xb5, 0x9b, 0x7d,
+	0xbb, 0x61, 0xf7, 0xed, 0x81, 0xdb, 0x7d, 0xf5, 0x66, 0xd0, 0xe9, 0x76, 0x9a, 0xa2, 0xa0, 0xca,
+	0x8b, 0xa5, 0x2e, 0x56, 0xc9, 0x0e, 0x24, 0x4c, 0xba, 0xc6, 0x8f, 0x0f, 0xe9, 0x9e, 0xed, 0xf5,
+	0x5f, 0xdb, 0xae, 0x88, 0xd4, 0xa7, 0x8b, 0xa5, 0xfe, 0xa8, 0x3a, 0xd0, 0xa3, 0x29, 0x0f, 0x69,
+	0x74, 0x9c, 0xd0, 0x7a, 0xeb, 0xba, 0xe2, 0xc9, 0x71, 0x42, 0x6b, 0x1e, 0x45, 0xea, 0xe9, 0xc7,
+	0x2f, 0x44, 0x70, 0x1a, 0xdf, 0x36, 0x04, 0xad, 0x36, 0x04, 0xfd, 0xdc, 0x10, 0xf4, 0x69, 0x4b,
+	0x84, 0xd5, 0x96, 0x08, 0x3f, 0xb6, 0x44, 0x78, 0x77, 0x31, 0x0e, 0xf9, 0x64, 0xee, 0x9b, 0x01,
+	0xc4, 0x56, 0xb1, 0xc1, 0x30, 0x7b, 0x11, 0x51, 0x3f, 0xb3, 0x0e, 0x7e, 0x0a, 0xcf, 0x67, 0x2c,
+	0xf3, 0xcf, 0x8a, 0x55, 0xbe, 0xfc, 0x3d, 0x00, 0xb3, 0x05, 0xf8, 0x7f, 0x46, 0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +184,12 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if this.Frozen != that1.Frozen {
+		return false
+	}
+	if this.MetadataLock != that1.MetadataLock {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +212,21 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.MetadataLock != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.MetadataLock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -174,6 +258,12 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.MetadataLock != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.MetadataLock))
+	}
 	return n
 }
 
@@ -244,6 +334,45 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLock", wireType)
+			}
+			m.MetadataLock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataLock |= MetadataLock(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -0,0 +1,209 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &MintAuthorization{}
+	_ authz.Authorization = &BurnAuthorization{}
+	_ authz.Authorization = &ForceTransferAuthorization{}
+)
+
+// NewMintAuthorization creates a new MintAuthorization object.
+func NewMintAuthorization(spendLimit sdk.Coins, allowedRecipients []string) *MintAuthorization {
+	return &MintAuthorization{
+		SpendLimit:        spendLimit,
+		AllowedRecipients: allowedRecipients,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MintAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMint{})
+}
+
+// Accept implements Authorization.Accept.
+func (a MintAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mMint, ok := msg.(*MsgMint)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.AllowedRecipients) > 0 {
+		recipient := mMint.MintToAddress
+		if recipient == "" {
+			recipient = mMint.Sender
+		}
+
+		if !containsAddress(a.AllowedRecipients, recipient) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot mint to %s", recipient)
+		}
+	}
+
+	limitLeft, err := subSpendLimit(a.SpendLimit, mMint.Amount)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: NewMintAuthorization(limitLeft, a.AllowedRecipients)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MintAuthorization) ValidateBasic() error {
+	if err := validateSpendLimit(a.SpendLimit); err != nil {
+		return err
+	}
+
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+		}
+	}
+
+	return nil
+}
+
+// NewBurnAuthorization creates a new BurnAuthorization object.
+func NewBurnAuthorization(spendLimit sdk.Coins, allowedFromAddresses []string) *BurnAuthorization {
+	return &BurnAuthorization{
+		SpendLimit:           spendLimit,
+		AllowedFromAddresses: allowedFromAddresses,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a BurnAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgBurn{})
+}
+
+// Accept implements Authorization.Accept.
+func (a BurnAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mBurn, ok := msg.(*MsgBurn)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.AllowedFromAddresses) > 0 {
+		from := mBurn.BurnFromAddress
+		if from == "" {
+			from = mBurn.Sender
+		}
+
+		if !containsAddress(a.AllowedFromAddresses, from) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot burn from %s", from)
+		}
+	}
+
+	limitLeft, err := subSpendLimit(a.SpendLimit, mBurn.Amount)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: NewBurnAuthorization(limitLeft, a.AllowedFromAddresses)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a BurnAuthorization) ValidateBasic() error {
+	if err := validateSpendLimit(a.SpendLimit); err != nil {
+		return err
+	}
+	return validateFromAddresses(a.AllowedFromAddresses)
+}
+
+// NewForceTransferAuthorization creates a new ForceTransferAuthorization object.
+func NewForceTransferAuthorization(spendLimit sdk.Coins, allowedFromAddresses []string) *ForceTransferAuthorization {
+	return &ForceTransferAuthorization{
+		SpendLimit:           spendLimit,
+		AllowedFromAddresses: allowedFromAddresses,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ForceTransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgForceTransfer{})
+}
+
+// Accept implements Authorization.Accept.
+func (a ForceTransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mForceTransfer, ok := msg.(*MsgForceTransfer)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.AllowedFromAddresses) > 0 && !containsAddress(a.AllowedFromAddresses, mForceTransfer.TransferFromAddress) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot transfer from %s", mForceTransfer.TransferFromAddress)
+	}
+
+	limitLeft, err := subSpendLimit(a.SpendLimit, mForceTransfer.Amount)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: NewForceTransferAuthorization(limitLeft, a.AllowedFromAddresses)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ForceTransferAuthorization) ValidateBasic() error {
+	if err := validateSpendLimit(a.SpendLimit); err != nil {
+		return err
+	}
+	return validateFromAddresses(a.AllowedFromAddresses)
+}
+
+// subSpendLimit deducts amount from spendLimit, failing if amount is not
+// covered by the limit.
+func subSpendLimit(spendLimit sdk.Coins, amount sdk.Coin) (sdk.Coins, error) {
+	limitLeft, isNegative := spendLimit.SafeSub(sdk.NewCoins(amount))
+	if isNegative {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	}
+	return limitLeft, nil
+}
+
+func validateSpendLimit(spendLimit sdk.Coins) error {
+	if spendLimit == nil {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !spendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit cannot be negative")
+	}
+
+	for _, coin := range spendLimit {
+		if _, _, err := DeconstructDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateFromAddresses(addresses []string) error {
+	for _, from := range addresses {
+		if _, err := sdk.AccAddressFromBech32(from); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid from address (%s)", err)
+		}
+	}
+	return nil
+}
+
+func containsAddress(addresses []string, address string) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,881 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintAuthorization allows the grantee to mint up to spend_limit of the
+// granter's denoms on behalf of the granter. If allowed_recipients is set,
+// tokens can only be minted to one of those addresses.
+type MintAuthorization struct {
+	SpendLimit        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit" yaml:"spend_limit"`
+	AllowedRecipients []string                                 `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty" yaml:"allowed_recipients"`
+}
+
+func (m *MintAuthorization) Reset()         { *m = MintAuthorization{} }
+func (m *MintAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MintAuthorization) ProtoMessage()    {}
+func (*MintAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c383c5c29831a0ec, []int{0}
+}
+func (m *MintAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAuthorization.Merge(m, src)
+}
+func (m *MintAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAuthorization proto.InternalMessageInfo
+
+func (m *MintAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *MintAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+// BurnAuthorization allows the grantee to burn up to spend_limit of the
+// granter's denoms on behalf of the granter. If allowed_from_addresses is set,
+// tokens can only be burned from one of those addresses.
+type BurnAuthorization struct {
+	SpendLimit           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit" yaml:"spend_limit"`
+	AllowedFromAddresses []string                                 `protobuf:"bytes,2,rep,name=allowed_from_addresses,json=allowedFromAddresses,proto3" json:"allowed_from_addresses,omitempty" yaml:"allowed_from_addresses"`
+}
+
+func (m *BurnAuthorization) Reset()         { *m = BurnAuthorization{} }
+func (m *BurnAuthorization) String() string { return proto.CompactTextString(m) }
+func (*BurnAuthorization) ProtoMessage()    {}
+func (*BurnAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c383c5c29831a0ec, []int{1}
+}
+func (m *BurnAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnAuthorization.Merge(m, src)
+}
+func (m *BurnAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnAuthorization proto.InternalMessageInfo
+
+func (m *BurnAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *BurnAuthorization) GetAllowedFromAddresses() []string {
+	if m != nil {
+		return m.AllowedFromAddresses
+	}
+	return nil
+}
+
+// ForceTransferAuthorization allows the grantee to force transfer up to
+// spend_limit of the granter's denoms on behalf of the granter. If
+// allowed_from_addresses is set, tokens can only be transferred from one of
+// those addresses.
+type ForceTransferAuthorization struct {
+	SpendLimit           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit" yaml:"spend_limit"`
+	AllowedFromAddresses []string                                 `protobuf:"bytes,2,rep,name=allowed_from_addresses,json=allowedFromAddresses,proto3" json:"allowed_from_addresses,omitempty" yaml:"allowed_from_addresses"`
+}
+
+func (m *ForceTransferAuthorization) Reset()         { *m = ForceTransferAuthorization{} }
+func (m *ForceTransferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ForceTransferAuthorization) ProtoMessage()    {}
+func (*ForceTransferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c383c5c29831a0ec, []int{2}
+}
+func (m *ForceTransferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceTransferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceTransferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForceTransferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceTransferAuthorization.Merge(m, src)
+}
+func (m *ForceTransferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceTransferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceTransferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForceTransferAuthorization proto.InternalMessageInfo
+
+func (m *ForceTransferAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *ForceTransferAuthorization) GetAllowedFromAddresses() []string {
+	if m != nil {
+		return m.AllowedFromAddresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MintAuthorization)(nil), "tokenfactory.v1beta1.MintAuthorization")
+	proto.RegisterType((*BurnAuthorization)(nil), "tokenfactory.v1beta1.BurnAuthorization")
+	proto.RegisterType((*ForceTransferAuthorization)(nil), "tokenfactory.v1beta1.ForceTransferAuthorization")
+}
+
+func init() { proto.RegisterFile("tokenfactory/v1beta1/authz.proto", fileDescriptor_c383c5c29831a0ec) }
+
+var fileDescriptor_c383c5c29831a0ec = []byte{
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x53, 0xcd, 0x0a, 0xd3, 0x40,
+	0x10, 0x4e, 0x5a, 0x10, 0xdc, 0xe2, 0x21, 0xa1, 0x48, 0x5b, 0x68, 0x52, 0x73, 0x2a, 0x42, 0x13,
+	0xaa, 0xb7, 0xde, 0x1a, 0xa5, 0xa7, 0x7a, 0x09, 0x82, 0xe0, 0x25, 0x6c, 0x92, 0x6d, 0xbb, 0x34,
+	0xd9, 0x09, 0xbb, 0x1b, 0xa5, 0x3d, 0x8a, 0x57, 0xc1, 0xe7, 0xf0, 0xec, 0x43, 0xf4, 0x58, 0x3c,
+	0x79, 0x8a, 0xd2, 0xbe, 0x41, 0x6f, 0xde, 0xa4, 0xf9, 0x29, 0x0d, 0xfa, 0x00, 0x82, 0xa7, 0xdd,
+	0x99, 0xef, 0x9b, 0x99, 0xef, 0x1b, 0x18, 0x34, 0x92, 0xb0, 0x25, 0x6c, 0x85, 0x43, 0x09, 0x7c,
+	0xe7, 0xbc, 0x9b, 0x06, 0x44, 0xe2, 0xa9, 0x83, 0x33, 0xb9, 0xd9, 0xdb, 0x29, 0x07, 0x09, 0x7a,
+	0xf7, 0x9e, 0x61, 0x57, 0x8c, 0x41, 0x77, 0x0d, 0x6b, 0x28, 0x08, 0xce, 0xf5, 0x57, 0x72, 0x07,
+	0xfd, 0x10, 0x44, 0x02, 0xc2, 0x2f, 0x81, 0x32, 0xa8, 0x20, 0xa3, 0x8c, 0x9c, 0x00, 0x0b, 0x72,
+	0x9b, 0x13, 0x02, 0x65, 0x25, 0x6e, 0xfd, 0x52, 0x91, 0xf6, 0x8a, 0x32, 0x39, 0xcf, 0xe4, 0x06,
+	0x38, 0xdd, 0x63, 0x49, 0x81, 0xe9, 0x1f, 0x54, 0xd4, 0x11, 0x29, 0x61, 0x91, 0x1f, 0xd3, 0x84,
+	0xca, 0x9e, 0x3a, 0x6a, 0x8f, 0x3b, 0xcf, 0xfa, 0x76, 0xd5, 0xfa, 0xda, 0xac, 0x96, 0x64, 0xbf,
+	0x00, 0xca, 0xdc, 0xc5, 0x21, 0x37, 0x95, 0x4b, 0x6e, 0xea, 0x3b, 0x9c, 0xc4, 0x33, 0xeb, 0xae,
+	0xd6, 0xfa, 0xf2, 0xc3, 0x1c, 0xaf, 0xa9, 0xdc, 0x64, 0x81, 0x1d, 0x42, 0x52, 0xa9, 0xab, 0x9e,
+	0x89, 0x88, 0xb6, 0x8e, 0xdc, 0xa5, 0x44, 0x14, 0x6d, 0x84, 0x87, 0x8a, 0xca, 0xe5, 0xb5, 0x50,
+	0x5f, 0x22, 0x1d, 0xc7, 0x31, 0xbc, 0x27, 0x91, 0xcf, 0x49, 0x48, 0x53, 0x4a, 0x98, 0x14, 0xbd,
+	0xd6, 0xa8, 0x3d, 0x7e, 0xe8, 0x0e, 0x2f, 0xb9, 0xd9, 0x2f, 0x67, 0xfd, 0xc9, 0xb1, 0x3c, 0xad,
+	0x4a, 0x7a, 0xb7, 0xdc, 0x4c, 0xfb, 0xf6, 0x75, 0xf2, 0xa8, 0xe1, 0xd2, 0xfa, 0xd8, 0x42, 0x9a,
+	0x9b, 0x71, 0xf6, 0x0f, 0x7a, 0x7f, 0x83, 0x1e, 0xd7, 0xbe, 0x56, 0x1c, 0x12, 0x1f, 0x47, 0x11,
+	0x27, 0x42, 0x90, 0xda, 0xff, 0x93, 0x4b, 0x6e, 0x0e, 0x9b, 0xfe, 0x9b, 0x3c, 0xcb, 0xeb, 0x56,
+	0xc0, 0x82, 0x43, 0x32, 0xaf, 0xd3, 0x7f, 0x5b, 0xc3, 0xa7, 0x16, 0x1a, 0x2c, 0x80, 0x87, 0xe4,
+	0x35, 0xc7, 0x4c, 0xac, 0x08, 0xff, 0xbf, 0xf7, 0xe1, 0xbe, 0x3c, 0x9c, 0x0c, 0xf5, 0x78, 0x32,
+	0xd4, 0x9f, 0x27, 0x43, 0xfd, 0x7c, 0x36, 0x94, 0xe3, 0xd9, 0x50, 0xbe, 0x9f, 0x0d, 0xe5, 0xed,
+	0xd3, 0x3b, 0xed, 0x85, 0x66, 0x2a, 0x26, 0x31, 0x0e, 0x84, 0xd3, 0xb8, 0xe6, 0xc2, 0x43, 0xf0,
+	0xa0, 0xb8, 0xaf, 0xe7, 0xbf, 0x07, 0x00, 0xdd, 0xf3, 0xa6, 0xf8, 0xea, 0x03, 0x00, 0x00,
+}
+
+func (m *MintAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedFromAddresses) > 0 {
+		for iNdEx := len(m.AllowedFromAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFromAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedFromAddresses[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedFromAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceTransferAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceTransferAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceTransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedFromAddresses) > 0 {
+		for iNdEx := len(m.AllowedFromAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFromAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedFromAddresses[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedFromAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurnAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedFromAddresses) > 0 {
+		for _, s := range m.AllowedFromAddresses {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForceTransferAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedFromAddresses) > 0 {
+		for _, s := range m.AllowedFromAddresses {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFromAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFromAddresses = append(m.AllowedFromAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceTransferAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceTransferAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceTransferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFromAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFromAddresses = append(m.AllowedFromAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
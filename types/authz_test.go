@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// TestMintAuthorization tests that mint authorizations decrement their spend limit and
+// respect the allowed recipients
+func TestMintAuthorization(t *testing.T) {
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := types.GetTokenDenom(granter.String(), "bitcoin")
+	require.NoError(t, err)
+	ctx := sdk.Context{}
+
+	auth := types.NewMintAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 100)), []string{recipient.String()})
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, "/tokenfactory.v1beta1.MsgMint", auth.MsgTypeURL())
+
+	// minting to a recipient that is not allowed is rejected
+	_, err = auth.Accept(ctx, types.NewMsgMint(granter.String(), sdk.NewInt64Coin(denom, 10)))
+	require.Error(t, err)
+
+	// minting a denom without a spend limit is rejected
+	otherDenom, err := types.GetTokenDenom(granter.String(), "ether")
+	require.NoError(t, err)
+	_, err = auth.Accept(ctx, types.NewMsgMintTo(granter.String(), sdk.NewInt64Coin(otherDenom, 10), recipient.String()))
+	require.Error(t, err)
+
+	// minting more than the spend limit is rejected
+	_, err = auth.Accept(ctx, types.NewMsgMintTo(granter.String(), sdk.NewInt64Coin(denom, 101), recipient.String()))
+	require.Error(t, err)
+
+	resp, err := auth.Accept(ctx, types.NewMsgMintTo(granter.String(), sdk.NewInt64Coin(denom, 40), recipient.String()))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewMintAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 60)), []string{recipient.String()}), resp.Updated)
+
+	// the authorization is deleted once the spend limit is used up
+	resp, err = resp.Updated.Accept(ctx, types.NewMsgMintTo(granter.String(), sdk.NewInt64Coin(denom, 60), recipient.String()))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// wrong message type is rejected
+	_, err = auth.Accept(ctx, types.NewMsgBurn(granter.String(), sdk.NewInt64Coin(denom, 10)))
+	require.Error(t, err)
+}
+
+// TestBurnAndForceTransferAuthorization tests that burn and force transfer authorizations
+// decrement their spend limit and respect the allowed from addresses
+func TestBurnAndForceTransferAuthorization(t *testing.T) {
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherHolder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := types.GetTokenDenom(granter.String(), "bitcoin")
+	require.NoError(t, err)
+	ctx := sdk.Context{}
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	for _, tc := range []struct {
+		desc string
+		auth authz.Authorization
+		msg  func(from sdk.AccAddress, amount int64) sdk.Msg
+	}{
+		{
+			desc: "burn",
+			auth: types.NewBurnAuthorization(spendLimit, []string{holder.String()}),
+			msg: func(from sdk.AccAddress, amount int64) sdk.Msg {
+				return types.NewMsgBurnFrom(granter.String(), sdk.NewInt64Coin(denom, amount), from.String())
+			},
+		},
+		{
+			desc: "force transfer",
+			auth: types.NewForceTransferAuthorization(spendLimit, []string{holder.String()}),
+			msg: func(from sdk.AccAddress, amount int64) sdk.Msg {
+				return types.NewMsgForceTransfer(granter.String(), sdk.NewInt64Coin(denom, amount), from.String(), granter.String())
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.NoError(t, tc.auth.ValidateBasic())
+
+			_, err := tc.auth.Accept(ctx, tc.msg(holder, 101))
+			require.Error(t, err)
+
+			// taking from an address that is not allowed is rejected
+			_, err = tc.auth.Accept(ctx, tc.msg(otherHolder, 10))
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+			resp, err := tc.auth.Accept(ctx, tc.msg(holder, 30))
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+
+			resp, err = resp.Updated.Accept(ctx, tc.msg(holder, 70))
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.True(t, resp.Delete)
+		})
+	}
+}
+
+// TestAuthorizationValidateBasic tests that authorizations only accept positive factory denom limits
+func TestAuthorizationValidateBasic(t *testing.T) {
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := types.GetTokenDenom(granter.String(), "bitcoin")
+	require.NoError(t, err)
+
+	require.Error(t, types.NewBurnAuthorization(nil, nil).ValidateBasic())
+	require.Error(t, types.NewBurnAuthorization(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)), nil).ValidateBasic())
+	require.Error(t, types.NewMintAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), []string{"invalid"}).ValidateBasic())
+	require.Error(t, types.NewForceTransferAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), []string{"invalid"}).ValidateBasic())
+	require.NoError(t, types.NewForceTransferAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), nil).ValidateBasic())
+}
+
+// TestAuthorizationAminoJSON tests that grants of the authorizations can be signed with
+// legacy Amino JSON
+func TestAuthorizationAminoJSON(t *testing.T) {
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := types.GetTokenDenom(granter.String(), "bitcoin")
+	require.NoError(t, err)
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+
+	for authType, auth := range map[string]authz.Authorization{
+		"osmosis/tokenfactory/mint-authorization": types.NewMintAuthorization(spendLimit, nil),
+		"osmosis/tokenfactory/burn-authorization": types.NewBurnAuthorization(spendLimit, nil),
+		"osmosis/tokenfactory/force-xfer-auth":    types.NewForceTransferAuthorization(spendLimit, nil),
+	} {
+		msg, err := authz.NewMsgGrant(granter, grantee, auth, time.Now())
+		require.NoError(t, err)
+		require.Contains(t, string(msg.GetSignBytes()), fmt.Sprintf(`"type":"%s"`, authType))
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateCollateralDenom checks that a denom can back a factory denom. Factory denoms
+// can't be collateral.
+func ValidateCollateralDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomBacking, err.Error())
+	}
+	if _, _, err := DeconstructDenom(denom); err == nil {
+		return errorsmod.Wrapf(ErrInvalidDenomBacking, "factory denom %s can't be collateral", denom)
+	}
+	return nil
+}
+
+func (backing DenomBacking) Validate() error {
+	if err := ValidateCollateralDenom(backing.CollateralDenom); err != nil {
+		return err
+	}
+
+	if backing.Escrowed.IsNil() || backing.Escrowed.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidDenomBacking, "invalid escrowed amount %s", backing.Escrowed)
+	}
+
+	return nil
+}
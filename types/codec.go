@@ -2,8 +2,11 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	// this line is used by starport scaffolding # 1
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -15,6 +18,44 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, "osmosis/tokenfactory/burn", nil)
 	cdc.RegisterConcrete(&MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "osmosis/tokenfactory/change-admin", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "osmosis/tokenfactory/set-denom-metadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateReservedSubdenoms{}, "osmosis/tokenfactory/update-reserved", nil)
+	cdc.RegisterConcrete(&MsgDelistDenom{}, "osmosis/tokenfactory/delist-denom", nil)
+	cdc.RegisterConcrete(&MsgDeleteDenom{}, "osmosis/tokenfactory/delete-denom", nil)
+	cdc.RegisterConcrete(&MsgGovSetDenomAdmin{}, "osmosis/tokenfactory/gov-set-admin", nil)
+	cdc.RegisterConcrete(&MsgGovFreezeDenom{}, "osmosis/tokenfactory/gov-freeze-denom", nil)
+	cdc.RegisterConcrete(&MsgLockDenomMetadata{}, "osmosis/tokenfactory/lock-metadata", nil)
+	cdc.RegisterConcrete(&MsgSetTokenProfile{}, "osmosis/tokenfactory/set-token-profile", nil)
+	cdc.RegisterConcrete(&MsgMintVesting{}, "osmosis/tokenfactory/mint-vesting", nil)
+	cdc.RegisterConcrete(&MsgClaimVested{}, "osmosis/tokenfactory/claim-vested", nil)
+	cdc.RegisterConcrete(&MsgCreateMintSchedule{}, "osmosis/tokenfactory/create-schedule", nil)
+	cdc.RegisterConcrete(&MsgCancelMintSchedule{}, "osmosis/tokenfactory/cancel-schedule", nil)
+	cdc.RegisterConcrete(&MsgTakeSnapshot{}, "osmosis/tokenfactory/take-snapshot", nil)
+	cdc.RegisterConcrete(&MsgDepositDistribution{}, "osmosis/tokenfactory/deposit-dist", nil)
+	cdc.RegisterConcrete(&MsgClaimDistribution{}, "osmosis/tokenfactory/claim-dist", nil)
+	cdc.RegisterConcrete(&MsgSetHolderIndex{}, "osmosis/tokenfactory/set-holder-index", nil)
+	cdc.RegisterConcrete(&MsgRegisterConversionRoute{}, "osmosis/tokenfactory/register-route", nil)
+	cdc.RegisterConcrete(&MsgConvert{}, "osmosis/tokenfactory/convert", nil)
+	cdc.RegisterConcrete(&MsgSetDenomBacking{}, "osmosis/tokenfactory/set-denom-backing", nil)
+	cdc.RegisterConcrete(&MsgWrap{}, "osmosis/tokenfactory/wrap", nil)
+	cdc.RegisterConcrete(&MsgUnwrap{}, "osmosis/tokenfactory/unwrap", nil)
+	cdc.RegisterConcrete(&MsgSetTransferFee{}, "osmosis/tokenfactory/set-transfer-fee", nil)
+	cdc.RegisterConcrete(&MsgSetRestricted{}, "osmosis/tokenfactory/set-restricted", nil)
+	cdc.RegisterConcrete(&MsgAddToAllowlist{}, "osmosis/tokenfactory/add-to-allowlist", nil)
+	cdc.RegisterConcrete(&MsgRemoveFromAllowlist{}, "osmosis/tokenfactory/rm-from-allowlist", nil)
+	cdc.RegisterConcrete(&MsgSetMaxBalance{}, "osmosis/tokenfactory/set-max-balance", nil)
+	cdc.RegisterConcrete(&MsgApprove{}, "osmosis/tokenfactory/approve", nil)
+	cdc.RegisterConcrete(&MsgTransferFrom{}, "osmosis/tokenfactory/transfer-from", nil)
+	cdc.RegisterConcrete(&MsgRevokeApproval{}, "osmosis/tokenfactory/revoke-approval", nil)
+
+	cdc.RegisterConcrete(&MintAuthorization{}, "osmosis/tokenfactory/mint-authorization", nil)
+	cdc.RegisterConcrete(&BurnAuthorization{}, "osmosis/tokenfactory/burn-authorization", nil)
+	cdc.RegisterConcrete(&ForceTransferAuthorization{}, "osmosis/tokenfactory/force-xfer-auth", nil)
+
+	cdc.RegisterConcrete(&UpdateReservedSubdenomsProposal{}, "osmosis/tokenfactory/reserved-proposal", nil)
+	cdc.RegisterConcrete(&DelistDenomProposal{}, "osmosis/tokenfactory/delist-proposal", nil)
+	cdc.RegisterConcrete(&SetDenomAdminProposal{}, "osmosis/tokenfactory/set-admin-proposal", nil)
+	cdc.RegisterConcrete(&FreezeDenomProposal{}, "osmosis/tokenfactory/freeze-proposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +66,48 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgBurn{},
 		// &MsgForceTransfer{},
 		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgUpdateReservedSubdenoms{},
+		&MsgDelistDenom{},
+		&MsgDeleteDenom{},
+		&MsgGovSetDenomAdmin{},
+		&MsgGovFreezeDenom{},
+		&MsgLockDenomMetadata{},
+		&MsgSetTokenProfile{},
+		&MsgMintVesting{},
+		&MsgClaimVested{},
+		&MsgCreateMintSchedule{},
+		&MsgCancelMintSchedule{},
+		&MsgTakeSnapshot{},
+		&MsgDepositDistribution{},
+		&MsgClaimDistribution{},
+		&MsgSetHolderIndex{},
+		&MsgRegisterConversionRoute{},
+		&MsgConvert{},
+		&MsgSetDenomBacking{},
+		&MsgWrap{},
+		&MsgUnwrap{},
+		&MsgSetTransferFee{},
+		&MsgSetRestricted{},
+		&MsgAddToAllowlist{},
+		&MsgRemoveFromAllowlist{},
+		&MsgSetMaxBalance{},
+		&MsgApprove{},
+		&MsgTransferFrom{},
+		&MsgRevokeApproval{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&MintAuthorization{},
+		&BurnAuthorization{},
+		&ForceTransferAuthorization{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdateReservedSubdenomsProposal{},
+		&DelistDenomProposal{},
+		&SetDenomAdminProposal{},
+		&FreezeDenomProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -39,7 +122,8 @@ func init() {
 	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
 	// used to properly serialize MsgGrant and MsgExec instances
 	sdk.RegisterLegacyAminoCodec(amino)
-	// RegisterCodec(authzcodec.Amino)
+	// on this SDK version, the authz Amino codec is the global legacy.Cdc
+	RegisterCodec(legacy.Cdc)
 
 	amino.Seal()
 }
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateConversionRoute checks that a conversion route converts a factory denom into
+// another one at a positive ratio
+func ValidateConversionRoute(sourceDenom, targetDenom string, ratio sdk.Dec) error {
+	if _, _, err := DeconstructDenom(sourceDenom); err != nil {
+		return err
+	}
+	if _, _, err := DeconstructDenom(targetDenom); err != nil {
+		return err
+	}
+	if sourceDenom == targetDenom {
+		return errorsmod.Wrapf(ErrInvalidConversionRoute, "can't convert %s into itself", sourceDenom)
+	}
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidConversionRoute, "ratio %s must be positive", ratio)
+	}
+	return nil
+}
+
+func (route ConversionRoute) Validate() error {
+	_, err := sdk.AccAddressFromBech32(route.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", err)
+	}
+
+	return ValidateConversionRoute(route.SourceDenom, route.TargetDenom, route.Ratio)
+}
+
+// IsExpired returns whether the deadline of the route has passed at blockTime
+func (route ConversionRoute) IsExpired(blockTime time.Time) bool {
+	return route.Deadline != nil && !blockTime.Before(*route.Deadline)
+}
+
+// ConvertAmount returns the amount of the target denom that amount of the source denom is
+// converted into, rounded down
+func (route ConversionRoute) ConvertAmount(amount sdk.Int) sdk.Int {
+	return route.Ratio.MulInt(amount).TruncateInt()
+}
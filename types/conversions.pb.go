@@ -0,0 +1,589 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/v1beta1/conversions.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConversionRoute allows the holders of a source denom to convert it into a
+// target denom with the same admin, at a fixed ratio of target tokens per
+// source token. The route is active as long as both denoms have the creator of
+// the route as their admin, and until its deadline if it has one.
+type ConversionRoute struct {
+	// creator is the admin of both denoms that registered the route.
+	Creator     string                                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	SourceDenom string                                 `protobuf:"bytes,2,opt,name=source_denom,json=sourceDenom,proto3" json:"source_denom,omitempty" yaml:"source_denom"`
+	TargetDenom string                                 `protobuf:"bytes,3,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty" yaml:"target_denom"`
+	Ratio       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio" yaml:"ratio"`
+	// deadline is the time from which the route can't be used anymore, if any.
+	Deadline *time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty" yaml:"deadline"`
+}
+
+func (m *ConversionRoute) Reset()         { *m = ConversionRoute{} }
+func (m *ConversionRoute) String() string { return proto.CompactTextString(m) }
+func (*ConversionRoute) ProtoMessage()    {}
+func (*ConversionRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8fbf784a67a0334, []int{0}
+}
+func (m *ConversionRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionRoute.Merge(m, src)
+}
+func (m *ConversionRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionRoute proto.InternalMessageInfo
+
+func (m *ConversionRoute) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ConversionRoute) GetSourceDenom() string {
+	if m != nil {
+		return m.SourceDenom
+	}
+	return ""
+}
+
+func (m *ConversionRoute) GetTargetDenom() string {
+	if m != nil {
+		return m.TargetDenom
+	}
+	return ""
+}
+
+func (m *ConversionRoute) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConversionRoute)(nil), "tokenfactory.v1beta1.ConversionRoute")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/v1beta1/conversions.proto", fileDescriptor_d8fbf784a67a0334)
+}
+
+var fileDescriptor_d8fbf784a67a0334 = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0xaa, 0xda, 0x40,
+	0x14, 0xc6, 0x33, 0x56, 0xfb, 0x27, 0x4a, 0x85, 0x54, 0x68, 0x70, 0x91, 0x91, 0x2c, 0x44, 0x4a,
+	0xcd, 0x60, 0xbb, 0x73, 0xd1, 0x85, 0x75, 0x5f, 0x08, 0xae, 0xba, 0x29, 0x93, 0x64, 0x4c, 0x83,
+	0x49, 0x8e, 0xcc, 0x4c, 0x04, 0xdf, 0xc2, 0x47, 0xe8, 0xe3, 0xb8, 0x74, 0x59, 0xba, 0x48, 0x8b,
+	0x76, 0x71, 0xd7, 0x3e, 0xc1, 0xc5, 0x99, 0x44, 0x72, 0xef, 0x2a, 0xf9, 0x38, 0xdf, 0xef, 0x3b,
+	0x1f, 0x87, 0x31, 0xc7, 0x12, 0x36, 0x2c, 0x5f, 0xd3, 0x50, 0x02, 0xdf, 0x93, 0xdd, 0x2c, 0x60,
+	0x92, 0xce, 0x48, 0x08, 0xf9, 0x8e, 0x71, 0x91, 0x40, 0x2e, 0xbc, 0x2d, 0x07, 0x09, 0xd6, 0xa0,
+	0xe9, 0xf3, 0x2a, 0xdf, 0x70, 0x10, 0x43, 0x0c, 0xca, 0x40, 0x6e, 0x7f, 0xda, 0x3b, 0xc4, 0x31,
+	0x40, 0x9c, 0x32, 0xa2, 0x54, 0x50, 0xac, 0x89, 0x4c, 0x32, 0x26, 0x24, 0xcd, 0xb6, 0xda, 0xe0,
+	0xfe, 0x6f, 0x99, 0xfd, 0xaf, 0xf7, 0x15, 0x3e, 0x14, 0x92, 0x59, 0x1f, 0xcd, 0x57, 0x21, 0x67,
+	0x54, 0x02, 0xb7, 0xd1, 0x08, 0x4d, 0xde, 0x2c, 0xac, 0x6b, 0x89, 0xdf, 0xee, 0x69, 0x96, 0xce,
+	0xdd, 0x6a, 0xe0, 0xfa, 0xb5, 0xc5, 0x9a, 0x9b, 0x3d, 0x01, 0x05, 0x0f, 0xd9, 0x8f, 0x88, 0xe5,
+	0x90, 0xd9, 0x2d, 0x85, 0xbc, 0xbf, 0x96, 0xf8, 0x9d, 0x46, 0x9a, 0x53, 0xd7, 0xef, 0x6a, 0xb9,
+	0xbc, 0xa9, 0x1b, 0x2b, 0x29, 0x8f, 0x99, 0xac, 0xd8, 0x17, 0xcf, 0xd9, 0xe6, 0xd4, 0xf5, 0xbb,
+	0x5a, 0x6a, 0x76, 0x65, 0x76, 0x38, 0x95, 0x09, 0xd8, 0x6d, 0x05, 0x7d, 0x39, 0x96, 0xd8, 0xf8,
+	0x53, 0xe2, 0x71, 0x9c, 0xc8, 0x9f, 0x45, 0xe0, 0x85, 0x90, 0x91, 0x10, 0x44, 0x06, 0xa2, 0xfa,
+	0x4c, 0x45, 0xb4, 0x21, 0x72, 0xbf, 0x65, 0xc2, 0x5b, 0xb2, 0xf0, 0x5a, 0xe2, 0x9e, 0x5e, 0xa1,
+	0x42, 0x5c, 0x5f, 0x87, 0x59, 0xdf, 0xcc, 0xd7, 0x11, 0xa3, 0x51, 0x9a, 0xe4, 0xcc, 0xee, 0x8c,
+	0xd0, 0xa4, 0xfb, 0x69, 0xe8, 0xe9, 0x1b, 0x7a, 0xf5, 0x0d, 0xbd, 0x55, 0x7d, 0x43, 0xd5, 0xb4,
+	0xaf, 0x63, 0x6a, 0xca, 0x3d, 0xfc, 0xc5, 0xc8, 0xbf, 0x87, 0xcc, 0xdb, 0x0f, 0xbf, 0x30, 0x5a,
+	0x2c, 0x8f, 0x67, 0x07, 0x9d, 0xce, 0x0e, 0xfa, 0x77, 0x76, 0xd0, 0xe1, 0xe2, 0x18, 0xa7, 0x8b,
+	0x63, 0xfc, 0xbe, 0x38, 0xc6, 0xf7, 0x0f, 0x8d, 0xbe, 0xaa, 0x67, 0x22, 0xa6, 0x29, 0x0d, 0x04,
+	0x79, 0xf2, 0x1a, 0x54, 0xef, 0xe0, 0xa5, 0xaa, 0xf0, 0xf9, 0x71, 0x00, 0xcd, 0x3a, 0xf4, 0xce,
+	0x2a, 0x02, 0x00, 0x00,
+}
+
+func (this *ConversionRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionRoute)
+	if !ok {
+		that2, ok := that.(ConversionRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.SourceDenom != that1.SourceDenom {
+		return false
+	}
+	if this.TargetDenom != that1.TargetDenom {
+		return false
+	}
+	if !this.Ratio.Equal(that1.Ratio) {
+		return false
+	}
+	if that1.Deadline == nil {
+		if this.Deadline != nil {
+			return false
+		}
+	} else if !this.Deadline.Equal(*that1.Deadline) {
+		return false
+	}
+	return true
+}
+func (m *ConversionRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintConversions(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConversions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintConversions(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceDenom) > 0 {
+		i -= len(m.SourceDenom)
+		copy(dAtA[i:], m.SourceDenom)
+		i = encodeVarintConversions(dAtA, i, uint64(len(m.SourceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintConversions(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConversions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConversions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConversionRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovConversions(uint64(l))
+	}
+	l = len(m.SourceDenom)
+	if l > 0 {
+		n += 1 + l + sovConversions(uint64(l))
+	}
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovConversions(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovConversions(uint64(l))
+	if m.Deadline != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovConversions(uint64(l))
+	}
+	return n
+}
+
+func sovConversions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConversions(x uint64) (n int) {
+	return sovConversions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConversionRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConversions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConversions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConversions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConversions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConversions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConversions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConversions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConversions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConversions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConversions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConversions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConversions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConversions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConversions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConversions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConversions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConversions = fmt.Errorf("proto: unexpected end of group")
+)
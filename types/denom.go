@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (record DenomCreationRecord) Validate() error {
+	_, err := sdk.AccAddressFromBech32(record.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", err)
+	}
+
+	if record.CreationHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidDenomCreationRecord, "negative creation height %d", record.CreationHeight)
+	}
+
+	err = record.CreationFee.Validate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomCreationRecord, "invalid creation fee (%s)", err)
+	}
+
+	return nil
+}
+
+func (deposit DenomDeposit) Validate() error {
+	_, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid depositor address (%s)", err)
+	}
+
+	err = deposit.Amount.Validate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomDeposit, "invalid amount (%s)", err)
+	}
+
+	return nil
+}
+
+// Size limits of the fields of a TokenProfile
+const (
+	MaxTokenProfileURILength         = 256
+	MaxTokenProfileDescriptionLength = 1024
+	MaxTokenProfileContactLength     = 128
+	MaxTokenProfileTags              = 10
+	MaxTokenProfileTagLength         = 32
+)
+
+func (profile TokenProfile) Validate() error {
+	if len(profile.LogoURI) > MaxTokenProfileURILength {
+		return errorsmod.Wrapf(ErrInvalidTokenProfile, "logo URI is longer than %d bytes", MaxTokenProfileURILength)
+	}
+
+	if profile.LogoURIHash != "" {
+		if profile.LogoURI == "" {
+			return errorsmod.Wrap(ErrInvalidTokenProfile, "logo URI hash is set without a logo URI")
+		}
+		hash, err := hex.DecodeString(profile.LogoURIHash)
+		if err != nil || len(hash) != 32 {
+			return errorsmod.Wrapf(ErrInvalidTokenProfile, "logo URI hash %s is not a hex encoded sha256 hash", profile.LogoURIHash)
+		}
+	}
+
+	if len(profile.Website) > MaxTokenProfileURILength {
+		return errorsmod.Wrapf(ErrInvalidTokenProfile, "website is longer than %d bytes", MaxTokenProfileURILength)
+	}
+
+	if len(profile.Description) > MaxTokenProfileDescriptionLength {
+		return errorsmod.Wrapf(ErrInvalidTokenProfile, "description is longer than %d bytes", MaxTokenProfileDescriptionLength)
+	}
+
+	if len(profile.Tags) > MaxTokenProfileTags {
+		return errorsmod.Wrapf(ErrInvalidTokenProfile, "more than %d tags", MaxTokenProfileTags)
+	}
+	seenTags := map[string]bool{}
+	for _, tag := range profile.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenProfile, "tag cannot be empty")
+		}
+		if len(tag) > MaxTokenProfileTagLength {
+			return errorsmod.Wrapf(ErrInvalidTokenProfile, "tag %s is longer than %d bytes", tag, MaxTokenProfileTagLength)
+		}
+		if seenTags[tag] {
+			return errorsmod.Wrapf(ErrInvalidTokenProfile, "duplicate tag: %s", tag)
+		}
+		seenTags[tag] = true
+	}
+
+	if len(profile.Contact) > MaxTokenProfileContactLength {
+		return errorsmod.Wrapf(ErrInvalidTokenProfile, "contact is longer than %d bytes", MaxTokenProfileContactLength)
+	}
+
+	return nil
+}
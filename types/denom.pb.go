@@ -0,0 +1,1538 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/v1beta1/denom.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomCreationRecord is the authoritative record of a denom created through
+// the tokenfactory module. A denom exists if and only if it has a creation
+// record, independent of the bank metadata of the denom.
+type DenomCreationRecord struct {
+	// creator is the address that created the denom.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// creation_height is the block height at which the denom was created.
+	CreationHeight int64 `protobuf:"varint,2,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+	// creation_time is the block time at which the denom was created.
+	CreationTime time.Time `protobuf:"bytes,3,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time" yaml:"creation_time"`
+	// creation_fee is the denom creation fee paid by the creator.
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=creation_fee,json=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_fee" yaml:"creation_fee"`
+}
+
+func (m *DenomCreationRecord) Reset()         { *m = DenomCreationRecord{} }
+func (m *DenomCreationRecord) String() string { return proto.CompactTextString(m) }
+func (*DenomCreationRecord) ProtoMessage()    {}
+func (*DenomCreationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03d357b7a62bbb53, []int{0}
+}
+func (m *DenomCreationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCreationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCreationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCreationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCreationRecord.Merge(m, src)
+}
+func (m *DenomCreationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCreationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCreationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCreationRecord proto.InternalMessageInfo
+
+func (m *DenomCreationRecord) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DenomCreationRecord) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *DenomCreationRecord) GetCreationTime() time.Time {
+	if m != nil {
+		return m.CreationTime
+	}
+	return time.Time{}
+}
+
+func (m *DenomCreationRecord) GetCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationFee
+	}
+	return nil
+}
+
+// CreatorCreationWindow counts the denoms created by creator within the
+// current creation window, which starts at window_start_height.
+type CreatorCreationWindow struct {
+	WindowStartHeight int64  `protobuf:"varint,1,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty" yaml:"window_start_height"`
+	Creations         uint64 `protobuf:"varint,2,opt,name=creations,proto3" json:"creations,omitempty" yaml:"creations"`
+	Creator           string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+}
+
+func (m *CreatorCreationWindow) Reset()         { *m = CreatorCreationWindow{} }
+func (m *CreatorCreationWindow) String() string { return proto.CompactTextString(m) }
+func (*CreatorCreationWindow) ProtoMessage()    {}
+func (*CreatorCreationWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03d357b7a62bbb53, []int{1}
+}
+func (m *CreatorCreationWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatorCreationWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatorCreationWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatorCreationWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatorCreationWindow.Merge(m, src)
+}
+func (m *CreatorCreationWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatorCreationWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatorCreationWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatorCreationWindow proto.InternalMessageInfo
+
+func (m *CreatorCreationWindow) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *CreatorCreationWindow) GetCreations() uint64 {
+	if m != nil {
+		return m.Creations
+	}
+	return 0
+}
+
+func (m *CreatorCreationWindow) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// DenomDeposit is the refundable denom creation deposit of a denom, held in
+// escrow by the module account.
+type DenomDeposit struct {
+	// depositor is the address that paid the deposit, and to which it is
+	// refunded.
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty" yaml:"depositor"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *DenomDeposit) Reset()         { *m = DenomDeposit{} }
+func (m *DenomDeposit) String() string { return proto.CompactTextString(m) }
+func (*DenomDeposit) ProtoMessage()    {}
+func (*DenomDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03d357b7a62bbb53, []int{2}
+}
+func (m *DenomDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomDeposit.Merge(m, src)
+}
+func (m *DenomDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomDeposit proto.InternalMessageInfo
+
+func (m *DenomDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *DenomDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// TokenProfile is the extended profile of a denom, with the information that
+// wallets and explorers display next to its bank metadata.
+type TokenProfile struct {
+	// logo_uri is the URI of the logo of the token.
+	LogoURI string `protobuf:"bytes,1,opt,name=logo_uri,json=logoUri,proto3" json:"logo_uri,omitempty" yaml:"logo_uri"`
+	// logo_uri_hash is the hex encoded sha256 hash of the document pointed to by
+	// logo_uri.
+	LogoURIHash string   `protobuf:"bytes,2,opt,name=logo_uri_hash,json=logoUriHash,proto3" json:"logo_uri_hash,omitempty" yaml:"logo_uri_hash"`
+	Website     string   `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty" yaml:"website"`
+	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Tags        []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" yaml:"tags"`
+	// contact is how to reach the project behind the token, such as an email
+	// address.
+	Contact string `protobuf:"bytes,6,opt,name=contact,proto3" json:"contact,omitempty" yaml:"contact"`
+}
+
+func (m *TokenProfile) Reset()         { *m = TokenProfile{} }
+func (m *TokenProfile) String() string { return proto.CompactTextString(m) }
+func (*TokenProfile) ProtoMessage()    {}
+func (*TokenProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03d357b7a62bbb53, []int{3}
+}
+func (m *TokenProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenProfile.Merge(m, src)
+}
+func (m *TokenProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenProfile proto.InternalMessageInfo
+
+func (m *TokenProfile) GetLogoURI() string {
+	if m != nil {
+		return m.LogoURI
+	}
+	return ""
+}
+
+func (m *TokenProfile) GetLogoURIHash() string {
+	if m != nil {
+		return m.LogoURIHash
+	}
+	return ""
+}
+
+func (m *TokenProfile) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *TokenProfile) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TokenProfile) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *TokenProfile) GetContact() string {
+	if m != nil {
+		return m.Contact
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DenomCreationRecord)(nil), "tokenfactory.v1beta1.DenomCreationRecord")
+	proto.RegisterType((*CreatorCreationWindow)(nil), "tokenfactory.v1beta1.CreatorCreationWindow")
+	proto.RegisterType((*DenomDeposit)(nil), "tokenfactory.v1beta1.DenomDeposit")
+	proto.RegisterType((*TokenProfile)(nil), "tokenfactory.v1beta1.TokenProfile")
+}
+
+func init() { proto.RegisterFile("tokenfactory/v1beta1/denom.proto", fileDescriptor_03d357b7a62bbb53) }
+
+var fileDescriptor_03d357b7a62bbb53 = []byte{
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0x9b, 0xd0, 0x92, 0x4b, 0x7f, 0x80, 0x1b, 0xaa, 0x90, 0xc1, 0x17, 0x1d, 0x03, 0x11,
+	0xa2, 0xb6, 0x5a, 0x16, 0xe8, 0x86, 0x53, 0x41, 0x11, 0x08, 0x21, 0xd3, 0x0a, 0x89, 0x25, 0x72,
+	0x9c, 0x8b, 0x73, 0x6a, 0xe2, 0x8b, 0x7c, 0x17, 0xaa, 0xfe, 0x01, 0xec, 0xfd, 0x13, 0x98, 0xf9,
+	0x23, 0x98, 0xcb, 0xd6, 0x0d, 0x26, 0x17, 0xa5, 0x0b, 0xb3, 0x37, 0x36, 0x74, 0xbf, 0x92, 0xb4,
+	0x45, 0xaa, 0x98, 0x72, 0xf9, 0xbe, 0xf7, 0xdd, 0xf3, 0xf7, 0xde, 0xb3, 0x41, 0x83, 0xd3, 0x43,
+	0x9c, 0xf4, 0xc2, 0x88, 0xd3, 0xf4, 0xd8, 0xfb, 0xb4, 0xd5, 0xc1, 0x3c, 0xdc, 0xf2, 0xba, 0x38,
+	0xa1, 0x43, 0x77, 0x94, 0x52, 0x4e, 0xed, 0xea, 0x3c, 0xc2, 0xd5, 0x88, 0x7a, 0x35, 0xa6, 0x31,
+	0x95, 0x00, 0x4f, 0x9c, 0x14, 0xb6, 0x0e, 0x63, 0x4a, 0xe3, 0x01, 0xf6, 0xe4, 0xbf, 0xce, 0xb8,
+	0xe7, 0x71, 0x32, 0xc4, 0x8c, 0x87, 0xc3, 0x91, 0x06, 0x38, 0x11, 0x65, 0x43, 0xca, 0xbc, 0x4e,
+	0xc8, 0xf0, 0x94, 0x2d, 0xa2, 0x24, 0x51, 0x7d, 0xf4, 0x67, 0x01, 0xac, 0xef, 0x0a, 0xf2, 0x56,
+	0x8a, 0x43, 0x4e, 0x68, 0x12, 0xe0, 0x88, 0xa6, 0x5d, 0xfb, 0x31, 0x58, 0x8a, 0x44, 0x85, 0xa6,
+	0x35, 0xab, 0x61, 0x35, 0xcb, 0xbe, 0x9d, 0x67, 0x70, 0xf5, 0x38, 0x1c, 0x0e, 0x76, 0x90, 0x6e,
+	0xa0, 0xc0, 0x40, 0xec, 0x16, 0x58, 0x8b, 0xf4, 0x7c, 0xbb, 0x8f, 0x49, 0xdc, 0xe7, 0xb5, 0x85,
+	0x86, 0xd5, 0x2c, 0xfa, 0xf5, 0x3c, 0x83, 0x1b, 0x73, 0x53, 0x33, 0x00, 0x0a, 0x56, 0x4d, 0x65,
+	0x4f, 0x16, 0xec, 0x10, 0xac, 0x4c, 0x31, 0x62, 0x8d, 0x5a, 0xb1, 0x61, 0x35, 0x2b, 0xdb, 0x75,
+	0x57, 0xed, 0xe8, 0x9a, 0x1d, 0xdd, 0x7d, 0xb3, 0xa3, 0xdf, 0x38, 0xcd, 0x60, 0x21, 0xcf, 0x60,
+	0xf5, 0x0a, 0x85, 0x18, 0x47, 0x27, 0xe7, 0xd0, 0x0a, 0x96, 0x4d, 0x4d, 0x0c, 0xd9, 0x9f, 0x2d,
+	0x30, 0x2d, 0xb4, 0x7b, 0x18, 0xd7, 0x4a, 0x8d, 0x62, 0xb3, 0xb2, 0x7d, 0xdf, 0x55, 0x2a, 0xb9,
+	0x42, 0x25, 0xa3, 0xb8, 0xdb, 0xa2, 0x24, 0xf1, 0x5f, 0x6a, 0x86, 0xf5, 0x2b, 0x0c, 0x3d, 0x8c,
+	0xd1, 0xd7, 0x73, 0xd8, 0x8c, 0x09, 0xef, 0x8f, 0x3b, 0x6e, 0x44, 0x87, 0x9e, 0x56, 0x5a, 0xfd,
+	0x6c, 0xb2, 0xee, 0xa1, 0xc7, 0x8f, 0x47, 0x98, 0xc9, 0x7b, 0x58, 0x50, 0x31, 0xa3, 0x2f, 0x30,
+	0xde, 0x29, 0xfd, 0xfe, 0x02, 0x2d, 0xf4, 0xdd, 0x02, 0xf7, 0x5a, 0x4a, 0x41, 0xa3, 0xfe, 0x07,
+	0x92, 0x74, 0xe9, 0x91, 0xfd, 0x16, 0xac, 0x1f, 0xc9, 0x53, 0x9b, 0xf1, 0x30, 0xe5, 0x46, 0x53,
+	0x4b, 0x6a, 0xea, 0xe4, 0x19, 0xac, 0xab, 0xc7, 0xf9, 0x07, 0x08, 0x05, 0x77, 0x55, 0xf5, 0xbd,
+	0x28, 0x6a, 0x69, 0xb7, 0x41, 0xd9, 0xd0, 0x33, 0xe9, 0x4c, 0xc9, 0xaf, 0xe6, 0x19, 0xbc, 0x73,
+	0x79, 0x29, 0x86, 0x82, 0x19, 0x6c, 0x3e, 0x01, 0xc5, 0x1b, 0x13, 0x80, 0xbe, 0x59, 0x60, 0x59,
+	0xe6, 0x68, 0x17, 0x8f, 0x28, 0x23, 0x92, 0xb2, 0xab, 0x8e, 0xd3, 0x08, 0xcd, 0x51, 0x4e, 0x5b,
+	0x28, 0x98, 0xc1, 0x6c, 0x0e, 0x16, 0xc3, 0x21, 0x1d, 0x27, 0x22, 0x3d, 0x37, 0xf8, 0xf2, 0x5c,
+	0xfb, 0xb2, 0xa2, 0xee, 0x53, 0x63, 0xff, 0xe7, 0x88, 0xe6, 0xd2, 0x66, 0xfc, 0x58, 0x00, 0xcb,
+	0xfb, 0xe2, 0xc5, 0x7b, 0x97, 0xd2, 0x1e, 0x19, 0x60, 0xfb, 0x19, 0xb8, 0x3d, 0xa0, 0x31, 0x6d,
+	0x8f, 0x53, 0xa2, 0x9f, 0xdf, 0x99, 0x64, 0x70, 0xe9, 0x0d, 0x8d, 0xe9, 0x41, 0xf0, 0x2a, 0xcf,
+	0xe0, 0x9a, 0xa2, 0x36, 0x20, 0x14, 0x2c, 0x89, 0xe3, 0x41, 0x4a, 0xec, 0xd7, 0x60, 0xc5, 0x54,
+	0xdb, 0xfd, 0x90, 0xf5, 0xa5, 0xe4, 0x65, 0xff, 0xe1, 0x24, 0x83, 0x15, 0x3d, 0xbf, 0x17, 0xb2,
+	0xfe, 0x2c, 0xb8, 0x97, 0xd0, 0x28, 0xa8, 0xe8, 0x8b, 0x04, 0x48, 0xf8, 0x70, 0x84, 0x3b, 0x8c,
+	0x70, 0x7c, 0xdd, 0x07, 0xdd, 0x40, 0x81, 0x81, 0xd8, 0x4f, 0x41, 0xa5, 0x8b, 0x59, 0x94, 0x92,
+	0x91, 0x70, 0xb1, 0x56, 0x92, 0x13, 0x1b, 0x79, 0x06, 0x6d, 0x23, 0xfc, 0xb4, 0x89, 0x82, 0x79,
+	0xa8, 0xfd, 0x00, 0x94, 0x78, 0x18, 0xb3, 0xda, 0xad, 0x46, 0xb1, 0x59, 0xf6, 0xd7, 0xf2, 0x0c,
+	0x56, 0xd4, 0x88, 0xa8, 0xa2, 0x40, 0x36, 0x65, 0x28, 0x68, 0xc2, 0xc3, 0x88, 0xd7, 0x16, 0xaf,
+	0x85, 0x42, 0x35, 0x44, 0x28, 0xd4, 0x49, 0x29, 0xeb, 0xef, 0x9e, 0x4e, 0x1c, 0xeb, 0x6c, 0xe2,
+	0x58, 0xbf, 0x26, 0x8e, 0x75, 0x72, 0xe1, 0x14, 0xce, 0x2e, 0x9c, 0xc2, 0xcf, 0x0b, 0xa7, 0xf0,
+	0xf1, 0xd1, 0x9c, 0x57, 0xd2, 0x23, 0xc2, 0x36, 0x07, 0x61, 0x87, 0x79, 0x97, 0xbe, 0x91, 0xd2,
+	0xb3, 0xce, 0xa2, 0x7c, 0xfd, 0x9f, 0xfc, 0x1d, 0x00, 0x7d, 0x95, 0xa3, 0xf5, 0x40, 0x05, 0x00,
+	0x00,
+}
+
+func (this *DenomCreationRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomCreationRecord)
+	if !ok {
+		that2, ok := that.(DenomCreationRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CreationTime.Equal(that1.CreationTime) {
+		return false
+	}
+	if len(this.CreationFee) != len(that1.CreationFee) {
+		return false
+	}
+	for i := range this.CreationFee {
+		if !this.CreationFee[i].Equal(&that1.CreationFee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomDeposit)
+	if !ok {
+		that2, ok := that.(DenomDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Depositor != that1.Depositor {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TokenProfile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenProfile)
+	if !ok {
+		that2, ok := that.(TokenProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogoURI != that1.LogoURI {
+		return false
+	}
+	if this.LogoURIHash != that1.LogoURIHash {
+		return false
+	}
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if this.Contact != that1.Contact {
+		return false
+	}
+	return true
+}
+func (m *DenomCreationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCreationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCreationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDenom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDenom(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.CreationHeight != 0 {
+		i = encodeVarintDenom(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatorCreationWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatorCreationWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatorCreationWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Creations != 0 {
+		i = encodeVarintDenom(dAtA, i, uint64(m.Creations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintDenom(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDenom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contact) > 0 {
+		i -= len(m.Contact)
+		copy(dAtA[i:], m.Contact)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.Contact)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintDenom(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LogoURIHash) > 0 {
+		i -= len(m.LogoURIHash)
+		copy(dAtA[i:], m.LogoURIHash)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.LogoURIHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LogoURI) > 0 {
+		i -= len(m.LogoURI)
+		copy(dAtA[i:], m.LogoURI)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.LogoURI)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenom(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenom(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomCreationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovDenom(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreationTime)
+	n += 1 + l + sovDenom(uint64(l))
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovDenom(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreatorCreationWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovDenom(uint64(m.WindowStartHeight))
+	}
+	if m.Creations != 0 {
+		n += 1 + sovDenom(uint64(m.Creations))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDenom(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LogoURI)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	l = len(m.LogoURIHash)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovDenom(uint64(l))
+		}
+	}
+	l = len(m.Contact)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	return n
+}
+
+func sovDenom(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenom(x uint64) (n int) {
+	return sovDenom(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomCreationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomCreationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomCreationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types1.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatorCreationWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatorCreationWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatorCreationWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creations", wireType)
+			}
+			m.Creations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Creations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogoURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoURIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogoURIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenom(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenom
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenom
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenom
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenom
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenom        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenom          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenom = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,54 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (holder DistributionHolder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(holder.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid distribution holder address (%s)", err)
+	}
+
+	if err := holder.RewardPerToken.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid reward per token of %s (%s)", holder.Address, err)
+	}
+
+	if err := holder.Pending.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid pending rewards of %s (%s)", holder.Address, err)
+	}
+
+	return nil
+}
+
+// ValidateDistribution checks that the reward states of the holders of a denom are valid,
+// and that none of them is ahead of the reward per token of the denom.
+func ValidateDistribution(distribution *Distribution, holders []DistributionHolder) error {
+	rewardPerToken := sdk.NewDecCoins()
+	if distribution != nil {
+		if err := distribution.RewardPerToken.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid reward per token (%s)", err)
+		}
+		rewardPerToken = distribution.RewardPerToken
+	}
+
+	seenHolders := map[string]bool{}
+	for _, holder := range holders {
+		if err := holder.Validate(); err != nil {
+			return err
+		}
+
+		if seenHolders[holder.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate distribution holder: %s", holder.Address)
+		}
+		seenHolders[holder.Address] = true
+
+		if _, hasNeg := rewardPerToken.SafeSub(holder.RewardPerToken); hasNeg {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "reward per token of %s is ahead of the denom", holder.Address)
+		}
+	}
+
+	return nil
+}
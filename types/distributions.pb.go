@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/v1beta1/distributions.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Distribution is the state of the reward distributions to the holders of a
+// denom. The reward coins are held by the module account.
+type Distribution struct {
+	// reward_per_token is the cumulative amount of rewards distributed per token
+	// of the denom.
+	RewardPerToken github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=reward_per_token,json=rewardPerToken,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_token" yaml:"reward_per_token"`
+}
+
+func (m *Distribution) Reset()         { *m = Distribution{} }
+func (m *Distribution) String() string { return proto.CompactTextString(m) }
+func (*Distribution) ProtoMessage()    {}
+func (*Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2485c3dc98027e33, []int{0}
+}
+func (m *Distribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Distribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Distribution.Merge(m, src)
+}
+func (m *Distribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Distribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Distribution proto.InternalMessageInfo
+
+func (m *Distribution) GetRewardPerToken() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerToken
+	}
+	return nil
+}
+
+// DistributionHolder is the reward state of a holder of a denom, which is
+// settled every time the balance of the holder changes.
+type DistributionHolder struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// reward_per_token is the reward_per_token of the denom when the rewards of
+	// the holder were last settled.
+	RewardPerToken github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward_per_token,json=rewardPerToken,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_token" yaml:"reward_per_token"`
+	// pending are the settled rewards of the holder that weren't claimed yet.
+	Pending github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=pending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pending" yaml:"pending"`
+}
+
+func (m *DistributionHolder) Reset()         { *m = DistributionHolder{} }
+func (m *DistributionHolder) String() string { return proto.CompactTextString(m) }
+func (*DistributionHolder) ProtoMessage()    {}
+func (*DistributionHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2485c3dc98027e33, []int{1}
+}
+func (m *DistributionHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionHolder.Merge(m, src)
+}
+func (m *DistributionHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionHolder proto.InternalMessageInfo
+
+func (m *DistributionHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DistributionHolder) GetRewardPerToken() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerToken
+	}
+	return nil
+}
+
+func (m *DistributionHolder) GetPending() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Distribution)(nil), "tokenfactory.v1beta1.Distribution")
+	proto.RegisterType((*DistributionHolder)(nil), "tokenfactory.v1beta1.DistributionHolder")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/v1beta1/distributions.proto", fileDescriptor_2485c3dc98027e33)
+}
+
+var fileDescriptor_2485c3dc98027e33 = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0x31, 0x4b, 0xfb, 0x40,
+	0x18, 0xc6, 0x73, 0xed, 0x9f, 0x7f, 0x31, 0x4a, 0x91, 0x50, 0xb0, 0x14, 0xb9, 0x94, 0x4c, 0x45,
+	0x6d, 0x8e, 0xea, 0xd6, 0xb1, 0x56, 0x70, 0x12, 0x29, 0x4e, 0x2e, 0xe5, 0x92, 0x3b, 0xe3, 0xd1,
+	0x36, 0x17, 0xee, 0xae, 0x4a, 0x07, 0xbf, 0x83, 0x93, 0xb3, 0xb3, 0xe0, 0xf7, 0xe8, 0xd8, 0xd1,
+	0xa9, 0x96, 0x76, 0x71, 0xee, 0x27, 0x90, 0xe4, 0x12, 0x89, 0xe2, 0xa0, 0x93, 0x53, 0x02, 0xef,
+	0xf3, 0xfe, 0x9e, 0xe7, 0x39, 0x5e, 0xb3, 0xa1, 0xf8, 0x80, 0x86, 0x57, 0xd8, 0x57, 0x5c, 0x4c,
+	0xd0, 0x4d, 0xcb, 0xa3, 0x0a, 0xb7, 0x10, 0x61, 0x52, 0x09, 0xe6, 0x8d, 0x15, 0xe3, 0xa1, 0x74,
+	0x23, 0xc1, 0x15, 0xb7, 0x2a, 0x79, 0xa5, 0x9b, 0x2a, 0x6b, 0x95, 0x80, 0x07, 0x3c, 0x11, 0xa0,
+	0xf8, 0x4f, 0x6b, 0x6b, 0xd0, 0xe7, 0x72, 0xc4, 0x25, 0xf2, 0xb0, 0xa4, 0x1f, 0x50, 0x9f, 0xb3,
+	0x50, 0xcf, 0x9d, 0x67, 0x60, 0x6e, 0x75, 0x73, 0x1e, 0xd6, 0x03, 0x30, 0xb7, 0x05, 0xbd, 0xc5,
+	0x82, 0xf4, 0x23, 0x2a, 0xfa, 0x89, 0x55, 0x15, 0xd4, 0x8b, 0x8d, 0xcd, 0xc3, 0x5d, 0x57, 0xc3,
+	0xdc, 0x18, 0x96, 0xf9, 0xba, 0x5d, 0xea, 0x1f, 0x73, 0x16, 0x76, 0xce, 0xa6, 0x73, 0xdb, 0x58,
+	0xcf, 0xed, 0x9d, 0x09, 0x1e, 0x0d, 0xdb, 0xce, 0x57, 0x86, 0xf3, 0xf4, 0x6a, 0xef, 0x07, 0x4c,
+	0x5d, 0x8f, 0x3d, 0xd7, 0xe7, 0x23, 0x94, 0xe6, 0xd2, 0x9f, 0xa6, 0x24, 0x03, 0xa4, 0x26, 0x11,
+	0x95, 0x19, 0x4e, 0xf6, 0xca, 0x9a, 0x70, 0x4e, 0xc5, 0x45, 0xbc, 0xdf, 0xfe, 0xf7, 0xf6, 0x68,
+	0x03, 0x67, 0x51, 0x30, 0xad, 0x7c, 0xde, 0x53, 0x3e, 0x24, 0x54, 0x58, 0x07, 0x66, 0x09, 0x13,
+	0x22, 0xa8, 0x94, 0x55, 0x50, 0x07, 0x8d, 0x8d, 0x8e, 0xb5, 0x9e, 0xdb, 0x65, 0x9d, 0x24, 0x1d,
+	0x38, 0xbd, 0x4c, 0xf2, 0x7d, 0xc7, 0xc2, 0xdf, 0x77, 0xb4, 0xee, 0xcc, 0x52, 0x44, 0x43, 0xc2,
+	0xc2, 0xa0, 0x5a, 0xfc, 0x41, 0x9c, 0x93, 0x34, 0x4e, 0x5a, 0x34, 0x5d, 0xfd, 0x75, 0x8a, 0xcc,
+	0x53, 0x3f, 0x71, 0xa7, 0x3b, 0x5d, 0x42, 0x30, 0x5b, 0x42, 0xb0, 0x58, 0x42, 0x70, 0xbf, 0x82,
+	0xc6, 0x6c, 0x05, 0x8d, 0x97, 0x15, 0x34, 0x2e, 0xf7, 0x72, 0xd4, 0x84, 0xc6, 0x64, 0x73, 0x88,
+	0x3d, 0x89, 0x3e, 0x9d, 0x6e, 0x42, 0xf7, 0xfe, 0x27, 0xf7, 0x75, 0xf4, 0x3e, 0x00, 0xa4, 0x59,
+	0x74, 0xe4, 0xd7, 0x02, 0x00, 0x00,
+}
+
+func (this *Distribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Distribution)
+	if !ok {
+		that2, ok := that.(Distribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RewardPerToken) != len(that1.RewardPerToken) {
+		return false
+	}
+	for i := range this.RewardPerToken {
+		if !this.RewardPerToken[i].Equal(&that1.RewardPerToken[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DistributionHolder) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionHolder)
+	if !ok {
+		that2, ok := that.(DistributionHolder)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.RewardPerToken) != len(that1.RewardPerToken) {
+		return false
+	}
+	for i := range this.RewardPerToken {
+		if !this.RewardPerToken[i].Equal(&that1.RewardPerToken[i]) {
+			return false
+		}
+	}
+	if len(this.Pending) != len(that1.Pending) {
+		return false
+	}
+	for i := range this.Pending {
+		if !this.Pending[i].Equal(&that1.Pending[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPerToken) > 0 {
+		for iNdEx := len(m.RewardPerToken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerToken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistributions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistributions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardPerToken) > 0 {
+		for iNdEx := len(m.RewardPerToken) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerToken[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistributions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistributions(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistributions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistributions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPerToken) > 0 {
+		for _, e := range m.RewardPerToken {
+			l = e.Size()
+			n += 1 + l + sovDistributions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistributions(uint64(l))
+	}
+	if len(m.RewardPerToken) > 0 {
+		for _, e := range m.RewardPerToken {
+			l = e.Size()
+			n += 1 + l + sovDistributions(uint64(l))
+		}
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovDistributions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDistributions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistributions(x uint64) (n int) {
+	return sovDistributions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistributions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerToken = append(m.RewardPerToken, types.DecCoin{})
+			if err := m.RewardPerToken[len(m.RewardPerToken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistributions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistributions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerToken = append(m.RewardPerToken, types.DecCoin{})
+			if err := m.RewardPerToken[len(m.RewardPerToken)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistributions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, types.DecCoin{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistributions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistributions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDistributions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDistributions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistributions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistributions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDistributions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDistributions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDistributions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDistributions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDistributions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDistributions = fmt.Errorf("proto: unexpected end of group")
+)
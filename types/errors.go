@@ -10,15 +10,50 @@ import (
 
 // x/tokenfactory module sentinel errors
 var (
-	ErrDenomExists              = errorsmod.Register(ModuleName, 2, "attempting to create a denom that already exists (has bank metadata)")
-	ErrUnauthorized             = errorsmod.Register(ModuleName, 3, "unauthorized account")
-	ErrInvalidDenom             = errorsmod.Register(ModuleName, 4, "invalid denom")
-	ErrInvalidCreator           = errorsmod.Register(ModuleName, 5, "invalid creator")
-	ErrInvalidAuthorityMetadata = errorsmod.Register(ModuleName, 6, "invalid authority metadata")
-	ErrInvalidGenesis           = errorsmod.Register(ModuleName, 7, "invalid genesis")
-	ErrSubdenomTooLong          = errorsmod.Register(ModuleName, 8, fmt.Sprintf("subdenom too long, max length is %d bytes", MaxSubdenomLength))
-	ErrCreatorTooLong           = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
-	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
-	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
-	ErrTrackBeforeSendOutOfGas  = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
+	ErrDenomExists                = errorsmod.Register(ModuleName, 2, "attempting to create a denom that already exists")
+	ErrUnauthorized               = errorsmod.Register(ModuleName, 3, "unauthorized account")
+	ErrInvalidDenom               = errorsmod.Register(ModuleName, 4, "invalid denom")
+	ErrInvalidCreator             = errorsmod.Register(ModuleName, 5, "invalid creator")
+	ErrInvalidAuthorityMetadata   = errorsmod.Register(ModuleName, 6, "invalid authority metadata")
+	ErrInvalidGenesis             = errorsmod.Register(ModuleName, 7, "invalid genesis")
+	ErrSubdenomTooLong            = errorsmod.Register(ModuleName, 8, fmt.Sprintf("subdenom too long, max length is %d bytes", MaxSubdenomLength))
+	ErrCreatorTooLong             = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
+	ErrDenomDoesNotExist          = errorsmod.Register(ModuleName, 10, "denom does not exist")
+	ErrBurnFromModuleAccount      = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
+	ErrTrackBeforeSendOutOfGas    = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
+	ErrInvalidDenomCreationRecord = errorsmod.Register(ModuleName, 13, "invalid denom creation record")
+	ErrSubdenomReserved           = errorsmod.Register(ModuleName, 14, "subdenom is reserved")
+	ErrInvalidReservedSubdenom    = errorsmod.Register(ModuleName, 15, "invalid reserved subdenom pattern")
+	ErrTooManyDenoms              = errorsmod.Register(ModuleName, 16, "creator has reached the maximum number of denoms")
+	ErrCreationRateLimited        = errorsmod.Register(ModuleName, 17, "creator has reached the maximum number of denom creations in the current window")
+	ErrInvalidDenomDeposit        = errorsmod.Register(ModuleName, 18, "invalid denom deposit")
+	ErrDenomHasSupply             = errorsmod.Register(ModuleName, 19, "denom has a non-zero total supply")
+	ErrDenomTombstoned            = errorsmod.Register(ModuleName, 20, "denom was deleted and can't be created again")
+	ErrDenomFrozen                = errorsmod.Register(ModuleName, 21, "denom is frozen by governance")
+	ErrDenomMetadataLocked        = errorsmod.Register(ModuleName, 22, "denom metadata is locked")
+	ErrInvalidDenomMetadata       = errorsmod.Register(ModuleName, 23, "invalid denom metadata")
+	ErrSymbolReserved             = errorsmod.Register(ModuleName, 24, "symbol is reserved")
+	ErrInvalidTokenProfile        = errorsmod.Register(ModuleName, 25, "invalid token profile")
+	ErrInvalidVestingSchedule     = errorsmod.Register(ModuleName, 26, "invalid vesting schedule")
+	ErrInvalidMintSchedule        = errorsmod.Register(ModuleName, 27, "invalid mint schedule")
+	ErrMintScheduleNotFound       = errorsmod.Register(ModuleName, 28, "mint schedule not found")
+	ErrSnapshotNotFound           = errorsmod.Register(ModuleName, 29, "snapshot not found")
+	ErrInvalidDistribution        = errorsmod.Register(ModuleName, 30, "invalid distribution")
+	ErrUnclaimedDistribution      = errorsmod.Register(ModuleName, 31, "denom has unclaimed distributed rewards")
+	ErrHolderIndexDisabled        = errorsmod.Register(ModuleName, 32, "denom has no holder index")
+	ErrInvalidConversionRoute     = errorsmod.Register(ModuleName, 33, "invalid conversion route")
+	ErrConversionRouteNotFound    = errorsmod.Register(ModuleName, 34, "conversion route not found")
+	ErrBackedDenom                = errorsmod.Register(ModuleName, 35, "operation not supported for backed denoms")
+	ErrInvalidDenomBacking        = errorsmod.Register(ModuleName, 36, "invalid denom backing")
+	ErrInvalidTransferFee         = errorsmod.Register(ModuleName, 37, "invalid transfer fee")
+	ErrNotAllowlisted             = errorsmod.Register(ModuleName, 38, "address is not on the allowlist of the denom")
+	ErrInvalidMaxBalance          = errorsmod.Register(ModuleName, 39, "invalid max balance")
+	ErrMaxBalanceExceeded         = errorsmod.Register(ModuleName, 40, "balance exceeds the max balance of the denom")
+	ErrInvalidAllowance           = errorsmod.Register(ModuleName, 41, "invalid allowance")
+	ErrAllowanceNotFound          = errorsmod.Register(ModuleName, 42, "allowance not found")
+	ErrInsufficientAllowance      = errorsmod.Register(ModuleName, 43, "insufficient allowance")
+	ErrForceTransferModuleAccount = errorsmod.Register(ModuleName, 44, "force transferring from or to a module account is not allowed")
+	ErrTooManyMintSchedules       = errorsmod.Register(ModuleName, 45, "too many mint schedules for denom")
+	ErrSendHooksNotRegistered     = errorsmod.Register(ModuleName, 46, "send hooks are not registered")
+	ErrTransferToModuleAccount    = errorsmod.Register(ModuleName, 47, "transferring from an allowance to a module account is not allowed")
 )
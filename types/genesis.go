@@ -13,8 +13,13 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		FactoryDenoms: []GenesisDenom{},
+		Params:           DefaultParams(),
+		FactoryDenoms:    []GenesisDenom{},
+		TombstonedDenoms: []string{},
+		VestingSchedules: []VestingSchedule{},
+		MintSchedules:    []MintSchedule{},
+		ConversionRoutes: []ConversionRoute{},
+		CreationWindows:  []CreatorCreationWindow{},
 	}
 }
 
@@ -34,7 +39,7 @@ func (gs GenesisState) Validate() error {
 		}
 		seenDenoms[denom.GetDenom()] = true
 
-		_, _, err := DeconstructDenom(denom.GetDenom())
+		creator, _, err := DeconstructDenom(denom.GetDenom())
 		if err != nil {
 			return err
 		}
@@ -45,6 +50,163 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.CreationRecord != nil {
+			err = denom.CreationRecord.Validate()
+			if err != nil {
+				return err
+			}
+
+			if denom.CreationRecord.Creator != creator {
+				return errorsmod.Wrapf(ErrInvalidDenomCreationRecord, "creator %s of denom %s doesn't match its creation record", creator, denom.GetDenom())
+			}
+		}
+
+		if denom.Deposit != nil {
+			err = denom.Deposit.Validate()
+			if err != nil {
+				return err
+			}
+		}
+
+		if denom.TokenProfile != nil {
+			err = denom.TokenProfile.Validate()
+			if err != nil {
+				return err
+			}
+		}
+
+		err = ValidateSnapshots(denom.Snapshots, denom.SnapshotCheckpoints, denom.SnapshotHolders)
+		if err != nil {
+			return err
+		}
+
+		err = ValidateDistribution(denom.Distribution, denom.DistributionHolders)
+		if err != nil {
+			return err
+		}
+
+		if denom.Backing != nil {
+			err = denom.Backing.Validate()
+			if err != nil {
+				return err
+			}
+		}
+
+		if denom.TransferFee != nil {
+			err = denom.TransferFee.Validate()
+			if err != nil {
+				return err
+			}
+		}
+
+		err = ValidateAllowlist(denom.Allowlist)
+		if err != nil {
+			return err
+		}
+
+		if denom.MaxBalance != nil {
+			err = denom.MaxBalance.Validate()
+			if err != nil {
+				return err
+			}
+		}
+
+		seenAllowances := map[string]bool{}
+		for _, allowance := range denom.Allowances {
+			if err := allowance.Validate(); err != nil {
+				return err
+			}
+			if allowance.Amount.Denom != denom.GetDenom() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "allowance of %s is in the genesis of %s", allowance.Amount.Denom, denom.GetDenom())
+			}
+
+			key := allowance.Owner + "/" + allowance.Spender
+			if seenAllowances[key] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate allowance of %s for the balance of %s", allowance.Spender, allowance.Owner)
+			}
+			seenAllowances[key] = true
+		}
+	}
+
+	seenTombstones := map[string]bool{}
+	for _, denom := range gs.GetTombstonedDenoms() {
+		if seenTombstones[denom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate tombstoned denom: %s", denom)
+		}
+		seenTombstones[denom] = true
+
+		if seenDenoms[denom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "tombstoned denom %s is a factory denom", denom)
+		}
+
+		if _, _, err := DeconstructDenom(denom); err != nil {
+			return err
+		}
+	}
+
+	seenScheduleIDs := map[uint64]bool{}
+	for _, schedule := range gs.GetVestingSchedules() {
+		if seenScheduleIDs[schedule.ID] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate vesting schedule id: %d", schedule.ID)
+		}
+		seenScheduleIDs[schedule.ID] = true
+
+		if schedule.ID >= gs.NextVestingScheduleID {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "vesting schedule id %d is not below the next vesting schedule id %d", schedule.ID, gs.NextVestingScheduleID)
+		}
+
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if _, _, err := DeconstructDenom(schedule.Amount.Denom); err != nil {
+			return err
+		}
+	}
+
+	seenMintScheduleIDs := map[uint64]bool{}
+	for _, schedule := range gs.GetMintSchedules() {
+		if seenMintScheduleIDs[schedule.ID] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate mint schedule id: %d", schedule.ID)
+		}
+		seenMintScheduleIDs[schedule.ID] = true
+
+		if schedule.ID >= gs.NextMintScheduleID {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "mint schedule id %d is not below the next mint schedule id %d", schedule.ID, gs.NextMintScheduleID)
+		}
+
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if _, _, err := DeconstructDenom(schedule.Amount.Denom); err != nil {
+			return err
+		}
+	}
+
+	seenConversionRoutes := map[string]bool{}
+	for _, route := range gs.GetConversionRoutes() {
+		if seenConversionRoutes[route.SourceDenom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate conversion route of %s", route.SourceDenom)
+		}
+		seenConversionRoutes[route.SourceDenom] = true
+
+		if err := route.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenCreators := map[string]bool{}
+	for _, window := range gs.GetCreationWindows() {
+		if seenCreators[window.Creator] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate creation window of %s", window.Creator)
+		}
+		seenCreators[window.Creator] = true
+
+		if _, err := sdk.AccAddressFromBech32(window.Creator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid creator %s of creation window: %s", window.Creator, err)
+		}
 	}
 
 	return nil
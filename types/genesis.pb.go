@@ -28,6 +28,25 @@ type GenesisState struct {
 	// params defines the paramaters of the module.
 	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	FactoryDenoms []GenesisDenom `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	// tombstoned_denoms defines the deleted denoms that can't be created again.
+	TombstonedDenoms []string `protobuf:"bytes,5,rep,name=tombstoned_denoms,json=tombstonedDenoms,proto3" json:"tombstoned_denoms,omitempty" yaml:"tombstoned_denoms"`
+	// vesting_schedules defines the vesting schedules whose amounts are held in
+	// escrow by the module account.
+	VestingSchedules []VestingSchedule `protobuf:"bytes,6,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules" yaml:"vesting_schedules"`
+	// next_vesting_schedule_id is the ID of the next vesting schedule.
+	NextVestingScheduleID uint64 `protobuf:"varint,7,opt,name=next_vesting_schedule_id,json=nextVestingScheduleId,proto3" json:"next_vesting_schedule_id,omitempty" yaml:"next_vesting_schedule_id"`
+	// mint_schedules defines the pending mint schedules.
+	MintSchedules []MintSchedule `protobuf:"bytes,8,rep,name=mint_schedules,json=mintSchedules,proto3" json:"mint_schedules" yaml:"mint_schedules"`
+	// next_mint_schedule_id is the ID of the next mint schedule.
+	NextMintScheduleID uint64 `protobuf:"varint,9,opt,name=next_mint_schedule_id,json=nextMintScheduleId,proto3" json:"next_mint_schedule_id,omitempty" yaml:"next_mint_schedule_id"`
+	// conversion_routes defines the registered conversion routes.
+	ConversionRoutes []ConversionRoute `protobuf:"bytes,10,rep,name=conversion_routes,json=conversionRoutes,proto3" json:"conversion_routes" yaml:"conversion_routes"`
+	// creation_windows defines the current creation windows of the creators,
+	// which count their denom creations towards the creation rate limit.
+	CreationWindows []CreatorCreationWindow `protobuf:"bytes,11,rep,name=creation_windows,json=creationWindows,proto3" json:"creation_windows" yaml:"creation_windows"`
+	// mint_schedule_cursor is the ID of the next mint schedule visited in
+	// EndBlock.
+	MintScheduleCursor uint64 `protobuf:"varint,12,opt,name=mint_schedule_cursor,json=mintScheduleCursor,proto3" json:"mint_schedule_cursor,omitempty" yaml:"mint_schedule_cursor"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,12 +96,105 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 	return nil
 }
 
+func (m *GenesisState) GetTombstonedDenoms() []string {
+	if m != nil {
+		return m.TombstonedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextVestingScheduleID() uint64 {
+	if m != nil {
+		return m.NextVestingScheduleID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMintSchedules() []MintSchedule {
+	if m != nil {
+		return m.MintSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextMintScheduleID() uint64 {
+	if m != nil {
+		return m.NextMintScheduleID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConversionRoutes() []ConversionRoute {
+	if m != nil {
+		return m.ConversionRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreationWindows() []CreatorCreationWindow {
+	if m != nil {
+		return m.CreationWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintScheduleCursor() uint64 {
+	if m != nil {
+		return m.MintScheduleCursor
+	}
+	return 0
+}
+
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, the DenomCreationRecord, the DenomDeposit and the TokenProfile
+// of the denom. If
+// the creation record is not set, it is created at genesis without a creation
+// fee.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	CreationRecord    *DenomCreationRecord   `protobuf:"bytes,3,opt,name=creation_record,json=creationRecord,proto3" json:"creation_record,omitempty" yaml:"creation_record"`
+	// deposit is the refundable creation deposit of the denom, if any. The
+	// deposited coins must be held by the module account.
+	Deposit *DenomDeposit `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+	// token_profile is the extended profile of the denom, if any.
+	TokenProfile *TokenProfile `protobuf:"bytes,5,opt,name=token_profile,json=tokenProfile,proto3" json:"token_profile,omitempty" yaml:"token_profile"`
+	// snapshots are the balance snapshots of the denom.
+	Snapshots []Snapshot `protobuf:"bytes,6,rep,name=snapshots,proto3" json:"snapshots" yaml:"snapshots"`
+	// snapshot_checkpoints are the balances of the accounts at the snapshots,
+	// recorded when their balances changed after a snapshot.
+	SnapshotCheckpoints []SnapshotCheckpoint `protobuf:"bytes,7,rep,name=snapshot_checkpoints,json=snapshotCheckpoints,proto3" json:"snapshot_checkpoints" yaml:"snapshot_checkpoints"`
+	// snapshot_holders are the accounts that received the denom, which are the
+	// candidate holders at a snapshot.
+	SnapshotHolders []string `protobuf:"bytes,8,rep,name=snapshot_holders,json=snapshotHolders,proto3" json:"snapshot_holders,omitempty" yaml:"snapshot_holders"`
+	// distribution is the reward distribution state of the denom, if any. The
+	// undistributed rewards must be held by the module account.
+	Distribution *Distribution `protobuf:"bytes,9,opt,name=distribution,proto3" json:"distribution,omitempty" yaml:"distribution"`
+	// distribution_holders are the reward states of the holders of the denom.
+	DistributionHolders []DistributionHolder `protobuf:"bytes,10,rep,name=distribution_holders,json=distributionHolders,proto3" json:"distribution_holders" yaml:"distribution_holders"`
+	// holder_index_enabled defines whether the denom has a holder index, which
+	// is rebuilt at genesis from the balances of the snapshot_holders.
+	HolderIndexEnabled bool `protobuf:"varint,11,opt,name=holder_index_enabled,json=holderIndexEnabled,proto3" json:"holder_index_enabled,omitempty" yaml:"holder_index_enabled"`
+	// backing is the collateral backing of the denom, if any. The escrowed
+	// collateral must be held by the module account.
+	Backing *DenomBacking `protobuf:"bytes,12,opt,name=backing,proto3" json:"backing,omitempty" yaml:"backing"`
+	// transfer_fee is the fee charged on every transfer of the denom, if any.
+	TransferFee *TransferFee `protobuf:"bytes,13,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee,omitempty" yaml:"transfer_fee"`
+	// restricted is whether the denom only moves between the addresses on its
+	// allowlist.
+	Restricted bool     `protobuf:"varint,14,opt,name=restricted,proto3" json:"restricted,omitempty" yaml:"restricted"`
+	Allowlist  []string `protobuf:"bytes,15,rep,name=allowlist,proto3" json:"allowlist,omitempty" yaml:"allowlist"`
+	// max_balance is the highest balance of the denom per address, if any.
+	MaxBalance *MaxBalance `protobuf:"bytes,16,opt,name=max_balance,json=maxBalance,proto3" json:"max_balance,omitempty" yaml:"max_balance"`
+	Allowances []Allowance `protobuf:"bytes,17,rep,name=allowances,proto3" json:"allowances" yaml:"allowances"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +244,111 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetCreationRecord() *DenomCreationRecord {
+	if m != nil {
+		return m.CreationRecord
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetDeposit() *DenomDeposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetTokenProfile() *TokenProfile {
+	if m != nil {
+		return m.TokenProfile
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetSnapshots() []Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetSnapshotCheckpoints() []SnapshotCheckpoint {
+	if m != nil {
+		return m.SnapshotCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetSnapshotHolders() []string {
+	if m != nil {
+		return m.SnapshotHolders
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetDistribution() *Distribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetDistributionHolders() []DistributionHolder {
+	if m != nil {
+		return m.DistributionHolders
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetHolderIndexEnabled() bool {
+	if m != nil {
+		return m.HolderIndexEnabled
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetBacking() *DenomBacking {
+	if m != nil {
+		return m.Backing
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetTransferFee() *TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetMaxBalance() *MaxBalance {
+	if m != nil {
+		return m.MaxBalance
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "tokenfactory.v1beta1.GenesisDenom")
@@ -142,29 +359,82 @@ func init() {
 }
 
 var fileDescriptor_873314f411151e56 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0xc9, 0xcf, 0x4e,
-	0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x41, 0x56, 0xa3, 0x07, 0x55, 0x23, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa0, 0x0f, 0x62,
-	0x41, 0xd4, 0x4a, 0xe9, 0x60, 0x35, 0x2f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2,
-	0x37, 0xb5, 0x24, 0x31, 0x25, 0xb1, 0x24, 0x11, 0xaa, 0x5a, 0x11, 0xab, 0xea, 0x82, 0xc4, 0xa2,
-	0xc4, 0x5c, 0xa8, 0xe5, 0x4a, 0x5b, 0x18, 0xb9, 0x78, 0xdc, 0x21, 0xce, 0x09, 0x2e, 0x49, 0x2c,
-	0x49, 0x15, 0xb2, 0xe2, 0x62, 0x83, 0x28, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd1,
-	0xc3, 0xe6, 0x3c, 0xbd, 0x00, 0xb0, 0x1a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x3a,
-	0x84, 0x32, 0xb8, 0xf8, 0xa0, 0xea, 0xe2, 0x53, 0x52, 0xf3, 0xf2, 0x73, 0x8b, 0x25, 0x98, 0x14,
-	0x98, 0x35, 0xb8, 0x8d, 0x94, 0xb0, 0x9b, 0x01, 0xb5, 0xd7, 0x05, 0xa4, 0xd4, 0x49, 0x16, 0x64,
-	0xd2, 0xa7, 0x7b, 0xf2, 0xa2, 0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0xa8, 0xe6, 0x28, 0x05, 0xf1,
-	0x42, 0x05, 0x5c, 0x20, 0xfc, 0x3d, 0x08, 0x67, 0x83, 0x45, 0x84, 0xd4, 0xb8, 0x58, 0xc1, 0x4a,
-	0xc1, 0xae, 0xe6, 0x74, 0x12, 0xf8, 0x74, 0x4f, 0x9e, 0x07, 0x62, 0x12, 0x58, 0x58, 0x29, 0x08,
-	0x22, 0x2d, 0x54, 0xc7, 0x25, 0x04, 0x0f, 0xad, 0xf8, 0x5c, 0x68, 0x70, 0x49, 0x30, 0x81, 0xbd,
-	0xaa, 0x83, 0xdd, 0x99, 0x60, 0x0b, 0x1c, 0xd1, 0x83, 0xd8, 0x49, 0x11, 0xea, 0x60, 0x49, 0x88,
-	0x35, 0x98, 0xa6, 0x2a, 0x05, 0x09, 0x62, 0x44, 0x8c, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e,
-	0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92,
-	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x5f, 0x9c, 0x9b, 0x5f, 0x9c, 0x59, 0xac, 0x9b,
-	0x93, 0x98, 0x54, 0xac, 0x8f, 0x12, 0x95, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x28,
-	0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xac, 0x00, 0x3f, 0x3e, 0x65, 0x02, 0x00, 0x00,
+	// 1199 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0x4f, 0x4f, 0x1b, 0x47,
+	0x18, 0xc6, 0x71, 0x30, 0x09, 0x1e, 0x1b, 0xb0, 0x07, 0x93, 0x4c, 0x80, 0x7a, 0xcd, 0xb4, 0x49,
+	0xdd, 0x36, 0x05, 0x85, 0xaa, 0x3d, 0xa0, 0x5e, 0xb2, 0xd0, 0xb4, 0x44, 0x4a, 0x45, 0x87, 0x2a,
+	0x55, 0x23, 0x55, 0xab, 0xf5, 0xee, 0x80, 0x57, 0x78, 0x77, 0xac, 0x9d, 0x31, 0x98, 0x4b, 0xd5,
+	0x4f, 0x50, 0xf5, 0x23, 0xf4, 0xe3, 0xe4, 0x54, 0xe5, 0xd8, 0xd3, 0xaa, 0x82, 0x4b, 0xcf, 0xfe,
+	0x04, 0xd5, 0xce, 0x8c, 0xd7, 0x63, 0x7b, 0x71, 0x6f, 0xf0, 0xfa, 0xf7, 0x3e, 0xcf, 0xbc, 0xef,
+	0xbb, 0xf3, 0x07, 0x60, 0xc1, 0x2e, 0x68, 0x74, 0xe6, 0x7a, 0x82, 0xc5, 0xd7, 0x7b, 0x97, 0xcf,
+	0xdb, 0x54, 0xb8, 0xcf, 0xf7, 0xce, 0x69, 0x44, 0x79, 0xc0, 0x77, 0x7b, 0x31, 0x13, 0x0c, 0xd6,
+	0x4d, 0x66, 0x57, 0x33, 0x9b, 0xf5, 0x73, 0x76, 0xce, 0x24, 0xb0, 0x97, 0xfe, 0xa5, 0xd8, 0xcd,
+	0x27, 0xb9, 0x7a, 0x6e, 0xb7, 0xcb, 0xae, 0xdc, 0xc8, 0xa3, 0x5a, 0x72, 0xf3, 0x59, 0x3e, 0xd6,
+	0x17, 0x1d, 0x16, 0x07, 0xe2, 0xfa, 0x35, 0x15, 0xae, 0xef, 0x0a, 0x57, 0xd3, 0xf9, 0x8b, 0x6c,
+	0xbb, 0xde, 0x45, 0x10, 0x9d, 0x6b, 0xe6, 0x69, 0x2e, 0xe3, 0xb1, 0xe8, 0x92, 0xc6, 0x3c, 0x60,
+	0xd1, 0xc8, 0xb9, 0x99, 0xcb, 0xf9, 0x34, 0x62, 0xa1, 0x26, 0x5a, 0xf9, 0x44, 0xc0, 0x45, 0x1c,
+	0xb4, 0xfb, 0xc2, 0xd0, 0xfa, 0x38, 0x97, 0x0c, 0xdd, 0x81, 0xd3, 0x76, 0xbb, 0x66, 0xb9, 0x3b,
+	0xb9, 0x60, 0xcf, 0x8d, 0xdd, 0x70, 0x84, 0x7c, 0x94, 0x8b, 0x70, 0xaf, 0x43, 0xfd, 0x7e, 0x97,
+	0xfe, 0x0f, 0x15, 0xb9, 0x3d, 0xde, 0x61, 0x82, 0xcf, 0xad, 0x40, 0xc4, 0x6e, 0xc4, 0xcf, 0x68,
+	0xec, 0x9c, 0x51, 0xca, 0xe7, 0x76, 0xf6, 0x92, 0x72, 0x91, 0x75, 0x16, 0xff, 0xb5, 0x0c, 0x2a,
+	0xdf, 0xaa, 0x0f, 0xe2, 0x54, 0xb8, 0x82, 0xc2, 0x03, 0x70, 0x5f, 0x2d, 0x1d, 0x15, 0x9a, 0x85,
+	0x56, 0x79, 0x7f, 0x7b, 0x37, 0xef, 0x03, 0xd9, 0x3d, 0x91, 0x8c, 0x5d, 0x7c, 0x97, 0x58, 0x0b,
+	0x44, 0x67, 0xc0, 0x0e, 0x58, 0xd5, 0x9c, 0x23, 0x7b, 0xce, 0xd1, 0xbd, 0xe6, 0x62, 0xab, 0xbc,
+	0x8f, 0xf3, 0x35, 0xb4, 0xef, 0x51, 0x8a, 0xda, 0x1f, 0xa4, 0x4a, 0xc3, 0xc4, 0xda, 0xb8, 0x76,
+	0xc3, 0xee, 0x01, 0x9e, 0xd4, 0xc1, 0x64, 0x45, 0x07, 0x24, 0xcc, 0xe1, 0x31, 0xa8, 0x09, 0x16,
+	0xb6, 0xb9, 0x60, 0x11, 0xf5, 0x47, 0x66, 0x4b, 0xcd, 0xc5, 0x56, 0xc9, 0xde, 0x1e, 0x26, 0x16,
+	0x52, 0x22, 0x33, 0x08, 0x26, 0xd5, 0x71, 0x4c, 0x4b, 0x09, 0x50, 0xd3, 0x2d, 0x71, 0xb2, 0x81,
+	0xa0, 0xfb, 0x72, 0xdd, 0x4f, 0xf2, 0xd7, 0xfd, 0x46, 0xe1, 0xa7, 0x9a, 0xb6, 0x9b, 0x7a, 0xe9,
+	0xda, 0x75, 0x46, 0x0d, 0x93, 0xea, 0xe5, 0x64, 0x0a, 0x87, 0x7d, 0x80, 0x22, 0x3a, 0x10, 0xce,
+	0x34, 0xec, 0x04, 0x3e, 0x7a, 0xd0, 0x2c, 0xb4, 0x8a, 0xf6, 0xd7, 0x37, 0x89, 0xb5, 0xf1, 0x3d,
+	0x1d, 0x88, 0x29, 0xbb, 0xe3, 0xa3, 0x61, 0x62, 0x59, 0xca, 0xea, 0x2e, 0x09, 0x4c, 0x36, 0xa2,
+	0x9c, 0x4c, 0x3f, 0x9d, 0x50, 0x18, 0x44, 0xc2, 0xa8, 0x74, 0x79, 0xde, 0x84, 0x5e, 0x07, 0x91,
+	0xc8, 0xca, 0x9c, 0x9a, 0xd0, 0xa4, 0x0e, 0x26, 0x2b, 0xa1, 0x01, 0x73, 0x18, 0x00, 0xb9, 0x04,
+	0x67, 0x02, 0x4b, 0xab, 0x2b, 0xc9, 0xea, 0xbe, 0xba, 0x49, 0x2c, 0x98, 0x56, 0x67, 0x5a, 0xc8,
+	0xd2, 0xb6, 0x8d, 0xd2, 0xa6, 0x93, 0x31, 0x81, 0xd1, 0x74, 0x8e, 0x9f, 0x4e, 0x70, 0x7c, 0x14,
+	0x38, 0x31, 0xeb, 0x0b, 0xca, 0x11, 0x98, 0x37, 0xc1, 0xc3, 0x0c, 0x27, 0x29, 0x3d, 0x3d, 0xc1,
+	0x19, 0x35, 0x4c, 0xaa, 0xde, 0x64, 0x0a, 0x87, 0x57, 0xa0, 0xea, 0xc5, 0xd4, 0x4d, 0x8f, 0x0c,
+	0xe7, 0x2a, 0x88, 0x7c, 0x76, 0xc5, 0x51, 0x59, 0x9a, 0x7e, 0x76, 0x87, 0x69, 0x4a, 0xb3, 0xf8,
+	0x50, 0x27, 0xfd, 0x24, 0x73, 0x6c, 0x4b, 0x5b, 0x3f, 0xd2, 0xd6, 0x53, 0x92, 0x98, 0xac, 0x79,
+	0x13, 0x09, 0x1c, 0xfe, 0x00, 0xea, 0x93, 0x7d, 0xf1, 0xfa, 0x31, 0x67, 0x31, 0xaa, 0xc8, 0xc6,
+	0x5a, 0xc3, 0xc4, 0xda, 0xca, 0x99, 0x90, 0xa6, 0x30, 0x81, 0xe6, 0x9c, 0x0e, 0x65, 0xf0, 0x55,
+	0x71, 0x79, 0xb1, 0x5a, 0x7c, 0x55, 0x5c, 0x2e, 0x56, 0x97, 0xc8, 0x66, 0x4c, 0x39, 0x8d, 0x2f,
+	0xa9, 0xef, 0xf0, 0x7e, 0x5b, 0x6e, 0x1b, 0xa7, 0xe7, 0x0a, 0x41, 0xe3, 0x88, 0x93, 0x9d, 0xd9,
+	0xdf, 0xe8, 0x80, 0x86, 0x3d, 0xe1, 0x78, 0xaa, 0x38, 0x8e, 0x7f, 0xaf, 0x64, 0x07, 0x8a, 0xdc,
+	0x60, 0xf0, 0x29, 0x58, 0x92, 0xa4, 0x3c, 0x4f, 0x4a, 0x76, 0x75, 0x98, 0x58, 0x15, 0xb5, 0x3e,
+	0x19, 0xc6, 0x44, 0xfd, 0x0c, 0x7f, 0x05, 0x30, 0xbb, 0x22, 0x9c, 0x50, 0xdf, 0x11, 0xe8, 0x9e,
+	0x3c, 0x84, 0x9e, 0xe5, 0x77, 0x54, 0x1a, 0xbc, 0x98, 0xbe, 0x57, 0xec, 0x1d, 0xdd, 0xd2, 0xc7,
+	0xca, 0x66, 0x56, 0x15, 0x93, 0xda, 0xcc, 0x6d, 0x04, 0x23, 0x90, 0x75, 0xda, 0x89, 0xa9, 0xc7,
+	0x62, 0x1f, 0x2d, 0x4a, 0xf3, 0x4f, 0xe6, 0x98, 0x8f, 0x86, 0x49, 0x64, 0x82, 0xbd, 0x39, 0x4c,
+	0xac, 0x87, 0x53, 0x83, 0x54, 0x5a, 0x98, 0xac, 0x7a, 0x13, 0x2c, 0x3c, 0x01, 0x0f, 0x7c, 0xda,
+	0x63, 0x3c, 0x10, 0xa8, 0xd8, 0x2c, 0xdc, 0xbd, 0x07, 0xa5, 0xcf, 0x91, 0x22, 0x6d, 0x38, 0x4c,
+	0xac, 0xd5, 0x51, 0xf7, 0x64, 0x08, 0x93, 0x91, 0x0c, 0x74, 0xc1, 0x8a, 0x54, 0x70, 0x7a, 0x31,
+	0x3b, 0x0b, 0xba, 0x14, 0x2d, 0xcd, 0xd3, 0xfd, 0x31, 0x0d, 0x9e, 0x28, 0xd2, 0x46, 0xc3, 0xc4,
+	0xaa, 0x8f, 0x0e, 0x4d, 0x43, 0x02, 0x93, 0x8a, 0x30, 0x38, 0xf8, 0x06, 0x94, 0xb2, 0xfb, 0x48,
+	0x1f, 0x92, 0x8d, 0x7c, 0xf9, 0x53, 0x8d, 0xd9, 0x48, 0x4f, 0xa3, 0xaa, 0xe4, 0xb3, 0x74, 0x4c,
+	0xc6, 0x52, 0xf0, 0xb7, 0x02, 0xa8, 0x8f, 0xfe, 0x73, 0xbc, 0x0e, 0xf5, 0x2e, 0x7a, 0x2c, 0x88,
+	0x04, 0x47, 0x0f, 0xa4, 0x47, 0x6b, 0xbe, 0xc7, 0x61, 0x96, 0x60, 0x7f, 0xa8, 0xdd, 0xb6, 0x26,
+	0xdd, 0x4c, 0x4d, 0x4c, 0xd6, 0xf9, 0x4c, 0x22, 0x87, 0x2f, 0x41, 0x35, 0xa3, 0x3b, 0xac, 0xeb,
+	0xd3, 0x58, 0x1d, 0x8e, 0x25, 0x7b, 0x6b, 0xbc, 0x3d, 0xa7, 0x09, 0x4c, 0xd6, 0x46, 0xa1, 0xef,
+	0x54, 0x04, 0x3a, 0xa0, 0x62, 0x3e, 0x27, 0x50, 0x69, 0xde, 0x10, 0x8e, 0x0c, 0xd2, 0x7e, 0x34,
+	0x4c, 0xac, 0x75, 0x3d, 0x5c, 0x23, 0x8e, 0xc9, 0x84, 0xa0, 0xec, 0x95, 0x19, 0xc8, 0x56, 0x0b,
+	0xe6, 0xf5, 0xca, 0x74, 0x52, 0x4b, 0x9d, 0xee, 0x55, 0x9e, 0x26, 0x26, 0xeb, 0xfe, 0x4c, 0xa2,
+	0x3c, 0x82, 0x14, 0xe0, 0x04, 0x91, 0x4f, 0x07, 0x0e, 0x8d, 0xdc, 0x76, 0x97, 0xfa, 0xa8, 0xdc,
+	0x2c, 0xb4, 0x96, 0xcd, 0x23, 0x28, 0x8f, 0xc2, 0x04, 0xaa, 0xf0, 0x71, 0x1a, 0xfd, 0x46, 0x05,
+	0xd3, 0xed, 0xa0, 0xdf, 0x7c, 0xa8, 0x32, 0xb7, 0x63, 0xf2, 0xb5, 0xa0, 0x48, 0x73, 0x3b, 0xe8,
+	0x64, 0x4c, 0x46, 0x32, 0xf0, 0x17, 0x50, 0x31, 0x5f, 0x45, 0x68, 0x45, 0xca, 0xee, 0xdc, 0xb1,
+	0x1b, 0x34, 0xf9, 0x92, 0x52, 0x73, 0x0e, 0xa6, 0x00, 0x26, 0x65, 0x31, 0xa6, 0xe0, 0x97, 0x00,
+	0xc4, 0x34, 0x6d, 0x8d, 0x27, 0xa8, 0x8f, 0x56, 0x65, 0xe5, 0x1b, 0xc3, 0xc4, 0xaa, 0xa9, 0xcc,
+	0xf1, 0x6f, 0x98, 0x18, 0x20, 0xdc, 0x07, 0x25, 0xf9, 0x60, 0xee, 0x06, 0x5c, 0xa0, 0x35, 0xf9,
+	0x7d, 0xd5, 0xc7, 0xbb, 0x23, 0xfb, 0x09, 0x93, 0x31, 0x06, 0x7f, 0x06, 0x65, 0xe3, 0xdd, 0x89,
+	0xaa, 0xb2, 0x90, 0xe6, 0x1d, 0x57, 0xb6, 0x3b, 0xb0, 0x15, 0x67, 0x3f, 0x1c, 0x26, 0x16, 0xd4,
+	0x57, 0xc1, 0x38, 0x1d, 0x13, 0x10, 0x66, 0x0c, 0x7c, 0x0b, 0xc0, 0xf8, 0xfd, 0x8e, 0x6a, 0xf2,
+	0x0b, 0xb2, 0xf2, 0x95, 0x5f, 0x8c, 0x38, 0xfb, 0xb1, 0xfe, 0x70, 0x6a, 0xc6, 0xa2, 0xa5, 0x00,
+	0x26, 0x86, 0xda, 0x41, 0xf1, 0xdf, 0x3f, 0xad, 0x82, 0x7d, 0xf4, 0xee, 0xa6, 0x51, 0x78, 0x7f,
+	0xd3, 0x28, 0xfc, 0x73, 0xd3, 0x28, 0xfc, 0x71, 0xdb, 0x58, 0x78, 0x7f, 0xdb, 0x58, 0xf8, 0xfb,
+	0xb6, 0xb1, 0xf0, 0xf6, 0xd3, 0xf3, 0x40, 0x74, 0xfa, 0xed, 0x5d, 0x8f, 0x85, 0x7b, 0x8c, 0x87,
+	0x8c, 0x07, 0xfc, 0xf3, 0xae, 0xdb, 0xe6, 0x7b, 0x13, 0xef, 0x56, 0x71, 0xdd, 0xa3, 0xbc, 0x7d,
+	0x5f, 0x3e, 0x57, 0xbf, 0xf8, 0x6f, 0x00, 0x8a, 0xdf, 0xd0, 0x32, 0xd3, 0x0c, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -192,6 +462,81 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.CreationRecord.Equal(that1.CreationRecord) {
+		return false
+	}
+	if !this.Deposit.Equal(that1.Deposit) {
+		return false
+	}
+	if !this.TokenProfile.Equal(that1.TokenProfile) {
+		return false
+	}
+	if len(this.Snapshots) != len(that1.Snapshots) {
+		return false
+	}
+	for i := range this.Snapshots {
+		if !this.Snapshots[i].Equal(&that1.Snapshots[i]) {
+			return false
+		}
+	}
+	if len(this.SnapshotCheckpoints) != len(that1.SnapshotCheckpoints) {
+		return false
+	}
+	for i := range this.SnapshotCheckpoints {
+		if !this.SnapshotCheckpoints[i].Equal(&that1.SnapshotCheckpoints[i]) {
+			return false
+		}
+	}
+	if len(this.SnapshotHolders) != len(that1.SnapshotHolders) {
+		return false
+	}
+	for i := range this.SnapshotHolders {
+		if this.SnapshotHolders[i] != that1.SnapshotHolders[i] {
+			return false
+		}
+	}
+	if !this.Distribution.Equal(that1.Distribution) {
+		return false
+	}
+	if len(this.DistributionHolders) != len(that1.DistributionHolders) {
+		return false
+	}
+	for i := range this.DistributionHolders {
+		if !this.DistributionHolders[i].Equal(&that1.DistributionHolders[i]) {
+			return false
+		}
+	}
+	if this.HolderIndexEnabled != that1.HolderIndexEnabled {
+		return false
+	}
+	if !this.Backing.Equal(that1.Backing) {
+		return false
+	}
+	if !this.TransferFee.Equal(that1.TransferFee) {
+		return false
+	}
+	if this.Restricted != that1.Restricted {
+		return false
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if this.Allowlist[i] != that1.Allowlist[i] {
+			return false
+		}
+	}
+	if !this.MaxBalance.Equal(that1.MaxBalance) {
+		return false
+	}
+	if len(this.Allowances) != len(that1.Allowances) {
+		return false
+	}
+	for i := range this.Allowances {
+		if !this.Allowances[i].Equal(&that1.Allowances[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +559,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintScheduleCursor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MintScheduleCursor))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CreationWindows) > 0 {
+		for iNdEx := len(m.CreationWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConversionRoutes) > 0 {
+		for iNdEx := len(m.ConversionRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextMintScheduleID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextMintScheduleID))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MintSchedules) > 0 {
+		for iNdEx := len(m.MintSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextVestingScheduleID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVestingScheduleID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TombstonedDenoms) > 0 {
+		for iNdEx := len(m.TombstonedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TombstonedDenoms[iNdEx])
+			copy(dAtA[i:], m.TombstonedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.TombstonedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.FactoryDenoms) > 0 {
 		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -261,42 +686,224 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+	if m.MaxBalance != nil {
+		{
+			size, err := m.MaxBalance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	var l int
+	if m.TransferFee != nil {
+		{
+			size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Backing != nil {
+		{
+			size, err := m.Backing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.HolderIndexEnabled {
+		i--
+		if m.HolderIndexEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DistributionHolders) > 0 {
+		for iNdEx := len(m.DistributionHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Distribution != nil {
+		{
+			size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SnapshotHolders) > 0 {
+		for iNdEx := len(m.SnapshotHolders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SnapshotHolders[iNdEx])
+			copy(dAtA[i:], m.SnapshotHolders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SnapshotHolders[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SnapshotCheckpoints) > 0 {
+		for iNdEx := len(m.SnapshotCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TokenProfile != nil {
+		{
+			size, err := m.TokenProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreationRecord != nil {
+		{
+			size, err := m.CreationRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
@@ -306,6 +913,45 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TombstonedDenoms) > 0 {
+		for _, s := range m.TombstonedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextVestingScheduleID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVestingScheduleID))
+	}
+	if len(m.MintSchedules) > 0 {
+		for _, e := range m.MintSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextMintScheduleID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextMintScheduleID))
+	}
+	if len(m.ConversionRoutes) > 0 {
+		for _, e := range m.ConversionRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreationWindows) > 0 {
+		for _, e := range m.CreationWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintScheduleCursor != 0 {
+		n += 1 + sovGenesis(uint64(m.MintScheduleCursor))
+	}
 	return n
 }
 
@@ -321,6 +967,76 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.CreationRecord != nil {
+		l = m.CreationRecord.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TokenProfile != nil {
+		l = m.TokenProfile.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SnapshotCheckpoints) > 0 {
+		for _, e := range m.SnapshotCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SnapshotHolders) > 0 {
+		for _, s := range m.SnapshotHolders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Distribution != nil {
+		l = m.Distribution.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DistributionHolders) > 0 {
+		for _, e := range m.DistributionHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HolderIndexEnabled {
+		n += 2
+	}
+	if m.Backing != nil {
+		l = m.Backing.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TransferFee != nil {
+		l = m.TransferFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxBalance != nil {
+		l = m.MaxBalance.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -426,59 +1142,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisDenom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisDenom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstonedDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -506,11 +1172,11 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TombstonedDenoms = append(m.TombstonedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -537,7 +1203,774 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVestingScheduleID", wireType)
+			}
+			m.NextVestingScheduleID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVestingScheduleID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedules = append(m.MintSchedules, MintSchedule{})
+			if err := m.MintSchedules[len(m.MintSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMintScheduleID", wireType)
+			}
+			m.NextMintScheduleID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMintScheduleID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionRoutes = append(m.ConversionRoutes, ConversionRoute{})
+			if err := m.ConversionRoutes[len(m.ConversionRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationWindows = append(m.CreationWindows, CreatorCreationWindow{})
+			if err := m.CreationWindows[len(m.CreationWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintScheduleCursor", wireType)
+			}
+			m.MintScheduleCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintScheduleCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationRecord == nil {
+				m.CreationRecord = &DenomCreationRecord{}
+			}
+			if err := m.CreationRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &DenomDeposit{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenProfile == nil {
+				m.TokenProfile = &TokenProfile{}
+			}
+			if err := m.TokenProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotCheckpoints = append(m.SnapshotCheckpoints, SnapshotCheckpoint{})
+			if err := m.SnapshotCheckpoints[len(m.SnapshotCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHolders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotHolders = append(m.SnapshotHolders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Distribution == nil {
+				m.Distribution = &Distribution{}
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionHolders = append(m.DistributionHolders, DistributionHolder{})
+			if err := m.DistributionHolders[len(m.DistributionHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderIndexEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HolderIndexEnabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backing == nil {
+				m.Backing = &DenomBacking{}
+			}
+			if err := m.Backing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferFee == nil {
+				m.TransferFee = &TransferFee{}
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBalance == nil {
+				m.MaxBalance = &MaxBalance{}
+			}
+			if err := m.MaxBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/tokenfactory/types"
@@ -86,6 +88,51 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid creation record",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						CreationRecord: &types.DenomCreationRecord{
+							Creator:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							CreationHeight: 10,
+							CreationFee:    sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)),
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "creation record of a different creator",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						CreationRecord: &types.DenomCreationRecord{
+							Creator: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "creation record with invalid fee",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						CreationRecord: &types.DenomCreationRecord{
+							Creator:     "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							CreationFee: sdk.Coins{sdk.Coin{Denom: "uosmo", Amount: sdk.NewInt(-1)}},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "multiple denoms",
 			genState: &types.GenesisState{
@@ -126,6 +173,273 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "reserved subdenom patterns and exempt creators",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					ReservedSubdenoms:              []string{"atom", "usd*"},
+					ReservedSubdenomExemptCreators: []string{"osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate reserved subdenom pattern",
+			genState: &types.GenesisState{
+				Params: types.Params{ReservedSubdenoms: []string{"usd*", "usd*"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reserved subdenom pattern",
+			genState: &types.GenesisState{
+				Params: types.Params{ReservedSubdenoms: []string{"usd$"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reserved subdenom exempt creator",
+			genState: &types.GenesisState{
+				Params: types.Params{ReservedSubdenomExemptCreators: []string{"invalid"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "creation rate limit without window",
+			genState: &types.GenesisState{
+				Params: types.Params{MaxCreationsPerWindow: 5},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid deposit",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+						Deposit: &types.DenomDeposit{
+							Depositor: "invalid",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "tombstoned denom is a factory denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+					},
+				},
+				TombstonedDenoms: []string{"factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin"},
+			},
+			valid: false,
+		},
+		{
+			desc: "denom unit patterns and reserved symbols",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DenomUnitPatterns: []string{"factory/*/*"},
+					ReservedSymbols:   []string{"OSMO", "ATOM"},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "denom unit pattern matching every denom",
+			genState: &types.GenesisState{
+				Params: types.Params{DenomUnitPatterns: []string{"**"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate reserved symbol",
+			genState: &types.GenesisState{
+				Params: types.Params{ReservedSymbols: []string{"OSMO", "osmo"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "vesting schedule id not below the next vesting schedule id",
+			genState: &types.GenesisState{
+				VestingSchedules: []types.VestingSchedule{
+					{
+						ID:        1,
+						Recipient: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						Amount:    sdk.NewInt64Coin("factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin", 1000),
+						Claimed:   sdk.ZeroInt(),
+						StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						CliffTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						EndTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+				NextVestingScheduleID: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "mint schedule with both periods",
+			genState: &types.GenesisState{
+				MintSchedules: []types.MintSchedule{
+					{
+						ID:            0,
+						Creator:       "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						Recipient:     "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						Amount:        sdk.NewInt64Coin("factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin", 1000),
+						PeriodBlocks:  10,
+						PeriodSeconds: 60,
+						DecayRate:     sdk.ZeroDec(),
+					},
+				},
+				NextMintScheduleID: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "snapshot checkpoint of an unknown snapshot",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						Snapshots: []types.Snapshot{
+							{ID: 1, Height: 10},
+						},
+						SnapshotCheckpoints: []types.SnapshotCheckpoint{
+							{Address: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44", SnapshotID: 2, Balance: sdk.NewInt(100)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "distribution holder ahead of the denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						Distribution: &types.Distribution{
+							RewardPerToken: sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 1)),
+						},
+						DistributionHolders: []types.DistributionHolder{
+							{
+								Address:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+								RewardPerToken: sdk.NewDecCoins(sdk.NewInt64DecCoin("uosmo", 2)),
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "conversion route into its source denom",
+			genState: &types.GenesisState{
+				ConversionRoutes: []types.ConversionRoute{
+					{
+						Creator:     "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						SourceDenom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						TargetDenom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						Ratio:       sdk.OneDec(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate creation window",
+			genState: &types.GenesisState{
+				CreationWindows: []types.CreatorCreationWindow{
+					{Creator: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79", Creations: 1},
+					{Creator: "cosmos18nlzrc0da2yny6jz44g3nlhegp77eq5apeau79", Creations: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "denom backed by a factory denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						Backing: &types.DenomBacking{
+							CollateralDenom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/litecoin",
+							Escrowed:        sdk.ZeroInt(),
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "transfer fee above 100%",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						TransferFee: &types.TransferFee{
+							BasisPoints: types.MaxTransferFeeBasisPoints + 1,
+							Recipient:   "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate allowlist address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:      "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						Restricted: true,
+						Allowlist: []string{
+							"osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							"osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max balance",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:      "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						MaxBalance: &types.MaxBalance{Amount: sdk.ZeroInt()},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowance of another denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						Allowances: []types.Allowance{
+							{
+								Owner:   "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+								Spender: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9",
+								Amount:  sdk.NewInt64Coin("factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/litecoin", 100),
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
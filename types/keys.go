@@ -1,7 +1,10 @@
 package types
 
 import (
-	"strings"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -21,30 +24,217 @@ const (
 	MemStoreKey = "mem_tokenfactory"
 )
 
-// KeySeparator is used to combine parts of the keys in the store
-const KeySeparator = "|"
+// Keys for the module store. Addresses and denoms inside keys are length
+// prefixed, so that no key is a prefix of another one.
+//
+// - 0x01 | len(denom) | denom | 0x01: DenomAuthorityMetadata
+// - 0x01 | len(denom) | denom | 0x02: DenomCreationRecord
+// - 0x01 | len(denom) | denom | 0x03: DenomDeposit
+// - 0x01 | len(denom) | denom | 0x04: TokenProfile
+// - 0x01 | len(denom) | denom | 0x05 | id: Snapshot
+// - 0x01 | len(denom) | denom | 0x06 | len(addr) | addr | id: SnapshotCheckpoint balance
+// - 0x01 | len(denom) | denom | 0x07 | addr: account that received the denom
+// - 0x01 | len(denom) | denom | 0x08: Distribution
+// - 0x01 | len(denom) | denom | 0x09 | addr: DistributionHolder
+// - 0x01 | len(denom) | denom | 0x0A: holder count, set when the holder index is enabled
+// - 0x01 | len(denom) | denom | 0x0B | addr: balance of a holder in the holder index
+// - 0x01 | len(denom) | denom | 0x0C | ^balance | addr: holder sorted by descending balance
+// - 0x01 | len(denom) | denom | 0x0D: DenomBacking
+// - 0x01 | len(denom) | denom | 0x0E: TransferFee
+// - 0x01 | len(denom) | denom | 0x0F: restricted flag, set when transfers are restricted
+// - 0x01 | len(denom) | denom | 0x10 | addr: address on the allowlist
+// - 0x01 | len(denom) | denom | 0x11: MaxBalance
+// - 0x01 | len(denom) | denom | 0x12 | len(owner) | owner | spender: Allowance
+// - 0x01 | len(denom) | denom | 0x13 | id: ID of a MintSchedule of the denom
+// - 0x02 | len(creatorAddr) | creatorAddr | denom: denom
+// - 0x03 | len(creatorAddr) | creatorAddr: CreatorCreationWindow
+// - 0x04 | denom: tombstone of a deleted denom
+// - 0x05 | len(recipientAddr) | recipientAddr | id: VestingSchedule
+// - 0x06: ID of the next VestingSchedule
+// - 0x07 | id: MintSchedule
+// - 0x08: ID of the next MintSchedule
+// - 0x09: ID of the next MintSchedule visited in EndBlock
+// - 0x0A | sourceDenom: ConversionRoute
+// - 0x0B | len(creatorAddr) | creatorAddr: number of denoms of the creator
+var (
+	DenomsPrefixKey                = []byte{0x01}
+	CreatorPrefixKey               = []byte{0x02}
+	CreatorCreationWindowPrefixKey = []byte{0x03}
+	DenomTombstonePrefixKey        = []byte{0x04}
+	VestingSchedulePrefixKey       = []byte{0x05}
+	NextVestingScheduleIDKey       = []byte{0x06}
+	MintSchedulePrefixKey          = []byte{0x07}
+	NextMintScheduleIDKey          = []byte{0x08}
+	MintScheduleCursorKey          = []byte{0x09}
+	ConversionRoutePrefixKey       = []byte{0x0A}
+	CreatorDenomCountPrefixKey     = []byte{0x0B}
+)
 
+// Keys inside the prefix store of a denom
 var (
-	DenomAuthorityMetadataKey      = "authoritymetadata"
-	DenomsPrefixKey                = "denoms"
-	CreatorPrefixKey               = "creator"
-	AdminPrefixKey                 = "admin"
-	BeforeSendHookAddressPrefixKey = "beforesendhook"
+	DenomAuthorityMetadataKey = []byte{0x01}
+	DenomCreationRecordKey    = []byte{0x02}
+	DenomDepositKey           = []byte{0x03}
+	DenomTokenProfileKey      = []byte{0x04}
+
+	DenomSnapshotPrefixKey           = []byte{0x05}
+	DenomSnapshotCheckpointPrefixKey = []byte{0x06}
+	DenomSnapshotHolderPrefixKey     = []byte{0x07}
+
+	DenomDistributionKey             = []byte{0x08}
+	DenomDistributionHolderPrefixKey = []byte{0x09}
+
+	DenomHolderCountKey           = []byte{0x0A}
+	DenomHolderBalancePrefixKey   = []byte{0x0B}
+	DenomHolderByBalancePrefixKey = []byte{0x0C}
+
+	DenomBackingKey = []byte{0x0D}
+
+	DenomTransferFeeKey = []byte{0x0E}
+
+	DenomRestrictedKey      = []byte{0x0F}
+	DenomAllowlistPrefixKey = []byte{0x10}
+
+	DenomMaxBalanceKey = []byte{0x11}
+
+	DenomAllowancePrefixKey = []byte{0x12}
+
+	DenomMintSchedulePrefixKey = []byte{0x13}
 )
 
+// holderRankBalanceLength is the length of the balance inside the keys of the holders
+// sorted by balance. It fits the 256 bits of an sdk.Int.
+const holderRankBalanceLength = 32
+
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
 // is stored
 func GetDenomPrefixStore(denom string) []byte {
-	return []byte(strings.Join([]string{DenomsPrefixKey, denom, ""}, KeySeparator))
+	return append(DenomsPrefixKey, address.MustLengthPrefix([]byte(denom))...)
 }
 
 // GetCreatorsPrefix returns the store prefix where the list of the denoms created by a specific
 // creator are stored
-func GetCreatorPrefix(creator string) []byte {
-	return []byte(strings.Join([]string{CreatorPrefixKey, creator, ""}, KeySeparator))
+func GetCreatorPrefix(creator sdk.AccAddress) []byte {
+	return append(CreatorPrefixKey, address.MustLengthPrefix(creator)...)
 }
 
 // GetCreatorsPrefix returns the store prefix where a list of all creator addresses are stored
 func GetCreatorsPrefix() []byte {
-	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
+	return CreatorPrefixKey
+}
+
+// GetCreatorCreationWindowKey returns the store key of the creation window of a creator
+func GetCreatorCreationWindowKey(creator sdk.AccAddress) []byte {
+	return append(CreatorCreationWindowPrefixKey, address.MustLengthPrefix(creator)...)
+}
+
+// GetCreatorDenomCountKey returns the store key of the number of denoms of a creator
+func GetCreatorDenomCountKey(creator sdk.AccAddress) []byte {
+	return append(CreatorDenomCountPrefixKey, address.MustLengthPrefix(creator)...)
+}
+
+// GetDenomTombstoneKey returns the store key of the tombstone of a deleted denom
+func GetDenomTombstoneKey(denom string) []byte {
+	return append(DenomTombstonePrefixKey, denom...)
+}
+
+// GetVestingSchedulesPrefix returns the store prefix where the vesting schedules of a
+// specific recipient are stored
+func GetVestingSchedulesPrefix(recipient sdk.AccAddress) []byte {
+	return append(VestingSchedulePrefixKey, address.MustLengthPrefix(recipient)...)
+}
+
+// GetVestingScheduleKey returns the store key of a vesting schedule
+func GetVestingScheduleKey(recipient sdk.AccAddress, id uint64) []byte {
+	return append(GetVestingSchedulesPrefix(recipient), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetMintScheduleKey returns the store key of a mint schedule
+func GetMintScheduleKey(id uint64) []byte {
+	return append(MintSchedulePrefixKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDenomMintScheduleKey returns the key of the ID of a mint schedule inside the prefix store
+// of its denom
+func GetDenomMintScheduleKey(id uint64) []byte {
+	return append(DenomMintSchedulePrefixKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetConversionRouteKey returns the store key of the conversion route of a source denom
+func GetConversionRouteKey(sourceDenom string) []byte {
+	return append(ConversionRoutePrefixKey, sourceDenom...)
+}
+
+// GetSnapshotKey returns the key of a snapshot inside the prefix store of its denom
+func GetSnapshotKey(id uint64) []byte {
+	return append(DenomSnapshotPrefixKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSnapshotCheckpointsPrefix returns the prefix where the snapshot checkpoints of a
+// specific account are stored inside the prefix store of a denom
+func GetSnapshotCheckpointsPrefix(addr sdk.AccAddress) []byte {
+	return append(DenomSnapshotCheckpointPrefixKey, address.MustLengthPrefix(addr)...)
+}
+
+// GetSnapshotCheckpointKey returns the key of the balance of an account at a snapshot
+// inside the prefix store of a denom
+func GetSnapshotCheckpointKey(addr sdk.AccAddress, snapshotID uint64) []byte {
+	return append(GetSnapshotCheckpointsPrefix(addr), sdk.Uint64ToBigEndian(snapshotID)...)
+}
+
+// GetSnapshotHolderKey returns the key of an account that received a denom inside the
+// prefix store of the denom
+func GetSnapshotHolderKey(addr sdk.AccAddress) []byte {
+	return append(DenomSnapshotHolderPrefixKey, addr...)
+}
+
+// GetDistributionHolderKey returns the key of the reward state of a holder inside the
+// prefix store of a denom
+func GetDistributionHolderKey(addr sdk.AccAddress) []byte {
+	return append(DenomDistributionHolderPrefixKey, addr...)
+}
+
+// GetAllowlistKey returns the key of an address on the allowlist inside the prefix store
+// of a denom
+func GetAllowlistKey(addr sdk.AccAddress) []byte {
+	return append(DenomAllowlistPrefixKey, addr...)
+}
+
+// GetAllowancesPrefix returns the prefix of the allowances of the spenders of an owner
+// inside the prefix store of a denom
+func GetAllowancesPrefix(owner sdk.AccAddress) []byte {
+	return append(DenomAllowancePrefixKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetAllowanceKey returns the key of the allowance of a spender for the balance of an owner
+// inside the prefix store of a denom
+func GetAllowanceKey(owner, spender sdk.AccAddress) []byte {
+	return append(GetAllowancesPrefix(owner), spender...)
+}
+
+// GetHolderBalanceKey returns the key of the balance of a holder in the holder index inside
+// the prefix store of a denom
+func GetHolderBalanceKey(addr sdk.AccAddress) []byte {
+	return append(DenomHolderBalancePrefixKey, addr...)
+}
+
+// GetHolderByBalanceKey returns the key of a holder sorted by descending balance inside the
+// prefix store of a denom. The balance is stored with its bits inverted, so that larger
+// balances sort first.
+func GetHolderByBalanceKey(balance sdk.Int, addr sdk.AccAddress) []byte {
+	bz := balance.BigInt().FillBytes(make([]byte, holderRankBalanceLength))
+	for i := range bz {
+		bz[i] = ^bz[i]
+	}
+	return append(append(DenomHolderByBalancePrefixKey, bz...), addr...)
+}
+
+// ParseHolderByBalanceKey returns the balance and the address of a holder from its key
+// without the DenomHolderByBalancePrefixKey prefix
+func ParseHolderByBalanceKey(key []byte) (sdk.Int, sdk.AccAddress) {
+	bz := make([]byte, holderRankBalanceLength)
+	for i := range bz {
+		bz[i] = ^key[i]
+	}
+	return sdk.NewIntFromBigInt(new(big.Int).SetBytes(bz)), sdk.AccAddress(key[holderRankBalanceLength:])
 }
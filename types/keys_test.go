@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHolderByBalanceKey(t *testing.T) {
+	addr := sdk.AccAddress([]byte("holder______________"))
+	maxBalance, ok := sdk.NewIntFromString("115792089237316195423570985008687907853269984665640564039457584007913129639935")
+	require.True(t, ok)
+
+	// larger balances sort first
+	keys := [][]byte{}
+	for _, balance := range []sdk.Int{maxBalance, sdk.NewInt(1000), sdk.NewInt(999), sdk.OneInt()} {
+		key := types.GetHolderByBalanceKey(balance, addr)
+		parsedBalance, parsedAddr := types.ParseHolderByBalanceKey(key[len(types.DenomHolderByBalancePrefixKey):])
+		require.Equal(t, balance, parsedBalance)
+		require.Equal(t, addr, parsedAddr)
+		keys = append(keys, key)
+	}
+	for i := 1; i < len(keys); i++ {
+		require.Equal(t, -1, bytes.Compare(keys[i-1], keys[i]))
+	}
+}
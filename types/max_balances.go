@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (maxBalance MaxBalance) Validate() error {
+	if maxBalance.Amount.IsNil() || !maxBalance.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMaxBalance, "amount must be positive, got %s", maxBalance.Amount)
+	}
+
+	seen := map[string]bool{}
+	for _, addr := range maxBalance.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMaxBalance, "invalid exempt address (%s)", err)
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidMaxBalance, "duplicate exempt address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// IsExempt returns whether addr can hold any balance of the denom
+func (maxBalance MaxBalance) IsExempt(addr sdk.AccAddress) bool {
+	for _, exempt := range maxBalance.ExemptAddresses {
+		if exempt == addr.String() {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,6 +18,35 @@ const (
 	TypeMsgChangeAdmin       = "change_admin"
 	TypeMsgSetDenomMetadata  = "set_denom_metadata"
 	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+
+	TypeMsgUpdateReservedSubdenoms = "update_reserved_subdenoms"
+	TypeMsgDelistDenom             = "delist_denom"
+	TypeMsgDeleteDenom             = "delete_denom"
+	TypeMsgGovSetDenomAdmin        = "gov_set_denom_admin"
+	TypeMsgGovFreezeDenom          = "gov_freeze_denom"
+	TypeMsgLockDenomMetadata       = "lock_denom_metadata"
+	TypeMsgSetTokenProfile         = "set_token_profile"
+	TypeMsgMintVesting             = "mint_vesting"
+	TypeMsgClaimVested             = "claim_vested"
+	TypeMsgCreateMintSchedule      = "create_mint_schedule"
+	TypeMsgCancelMintSchedule      = "cancel_mint_schedule"
+	TypeMsgTakeSnapshot            = "take_snapshot"
+	TypeMsgDepositDistribution     = "deposit_distribution"
+	TypeMsgClaimDistribution       = "claim_distribution"
+	TypeMsgSetHolderIndex          = "set_holder_index"
+	TypeMsgRegisterConversionRoute = "register_conversion_route"
+	TypeMsgConvert                 = "convert"
+	TypeMsgSetDenomBacking         = "set_denom_backing"
+	TypeMsgWrap                    = "wrap"
+	TypeMsgUnwrap                  = "unwrap"
+	TypeMsgSetTransferFee          = "set_transfer_fee"
+	TypeMsgSetRestricted           = "set_restricted"
+	TypeMsgAddToAllowlist          = "add_to_allowlist"
+	TypeMsgRemoveFromAllowlist     = "remove_from_allowlist"
+	TypeMsgSetMaxBalance           = "set_max_balance"
+	TypeMsgApprove                 = "approve"
+	TypeMsgTransferFrom            = "transfer_from"
+	TypeMsgRevokeApproval          = "revoke_approval"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -263,3 +294,1102 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgUpdateReservedSubdenoms{}
+
+// NewMsgUpdateReservedSubdenoms creates a message to update the reserved subdenom patterns and
+// the creators that are exempt from them
+func NewMsgUpdateReservedSubdenoms(authority string, addPatterns, removePatterns, addExemptCreators, removeExemptCreators []string) *MsgUpdateReservedSubdenoms {
+	return &MsgUpdateReservedSubdenoms{
+		Authority:            authority,
+		AddPatterns:          addPatterns,
+		RemovePatterns:       removePatterns,
+		AddExemptCreators:    addExemptCreators,
+		RemoveExemptCreators: removeExemptCreators,
+	}
+}
+
+func (m MsgUpdateReservedSubdenoms) Route() string { return RouterKey }
+func (m MsgUpdateReservedSubdenoms) Type() string  { return TypeMsgUpdateReservedSubdenoms }
+func (m MsgUpdateReservedSubdenoms) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	return validateReservedSubdenomsUpdate(m.AddPatterns, m.RemovePatterns, m.AddExemptCreators, m.RemoveExemptCreators)
+}
+
+// validateReservedSubdenomsUpdate validates the reserved subdenom patterns and exempt creators
+// to add and remove, which can each only be updated once
+func validateReservedSubdenomsUpdate(addPatterns, removePatterns, addExemptCreators, removeExemptCreators []string) error {
+	if len(addPatterns)+len(removePatterns)+len(addExemptCreators)+len(removeExemptCreators) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no reserved subdenoms or exempt creators to update")
+	}
+
+	seenPatterns := map[string]bool{}
+	for _, pattern := range append(append([]string{}, addPatterns...), removePatterns...) {
+		if seenPatterns[pattern] {
+			return errorsmod.Wrapf(ErrInvalidReservedSubdenom, "pattern %s is updated more than once", pattern)
+		}
+		seenPatterns[pattern] = true
+
+		if err := ValidateReservedSubdenomPattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	seenCreators := map[string]bool{}
+	for _, creator := range append(append([]string{}, addExemptCreators...), removeExemptCreators...) {
+		if seenCreators[creator] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "exempt creator %s is updated more than once", creator)
+		}
+		seenCreators[creator] = true
+
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid exempt creator address (%s)", err)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgUpdateReservedSubdenoms) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateReservedSubdenoms) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgDelistDenom{}
+
+// NewMsgDelistDenom creates a message to delist a denom
+func NewMsgDelistDenom(authority, denom string) *MsgDelistDenom {
+	return &MsgDelistDenom{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+func (m MsgDelistDenom) Route() string { return RouterKey }
+func (m MsgDelistDenom) Type() string  { return TypeMsgDelistDenom }
+func (m MsgDelistDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgDelistDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDelistDenom) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgDeleteDenom{}
+
+// NewMsgDeleteDenom creates a message to delete a denom
+func NewMsgDeleteDenom(sender, denom string, tombstone bool) *MsgDeleteDenom {
+	return &MsgDeleteDenom{
+		Sender:    sender,
+		Denom:     denom,
+		Tombstone: tombstone,
+	}
+}
+
+func (m MsgDeleteDenom) Route() string { return RouterKey }
+func (m MsgDeleteDenom) Type() string  { return TypeMsgDeleteDenom }
+func (m MsgDeleteDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgDeleteDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDeleteDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGovSetDenomAdmin{}
+
+// NewMsgGovSetDenomAdmin creates a message to reassign or remove the admin of a denom
+func NewMsgGovSetDenomAdmin(authority, denom, newAdmin string) *MsgGovSetDenomAdmin {
+	return &MsgGovSetDenomAdmin{
+		Authority: authority,
+		Denom:     denom,
+		NewAdmin:  newAdmin,
+	}
+}
+
+func (m MsgGovSetDenomAdmin) Route() string { return RouterKey }
+func (m MsgGovSetDenomAdmin) Type() string  { return TypeMsgGovSetDenomAdmin }
+func (m MsgGovSetDenomAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if m.NewAdmin != "" {
+		_, err = sdk.AccAddressFromBech32(m.NewAdmin)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgGovSetDenomAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGovSetDenomAdmin) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgGovFreezeDenom{}
+
+// NewMsgGovFreezeDenom creates a message to freeze or unfreeze a denom
+func NewMsgGovFreezeDenom(authority, denom string, frozen bool) *MsgGovFreezeDenom {
+	return &MsgGovFreezeDenom{
+		Authority: authority,
+		Denom:     denom,
+		Frozen:    frozen,
+	}
+}
+
+func (m MsgGovFreezeDenom) Route() string { return RouterKey }
+func (m MsgGovFreezeDenom) Type() string  { return TypeMsgGovFreezeDenom }
+func (m MsgGovFreezeDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgGovFreezeDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGovFreezeDenom) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgLockDenomMetadata{}
+
+// NewMsgLockDenomMetadata creates a message to lock the bank metadata of a denom
+func NewMsgLockDenomMetadata(sender, denom string, partial bool) *MsgLockDenomMetadata {
+	return &MsgLockDenomMetadata{
+		Sender:  sender,
+		Denom:   denom,
+		Partial: partial,
+	}
+}
+
+func (m MsgLockDenomMetadata) Route() string { return RouterKey }
+func (m MsgLockDenomMetadata) Type() string  { return TypeMsgLockDenomMetadata }
+func (m MsgLockDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgLockDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgLockDenomMetadata) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetTokenProfile{}
+
+// NewMsgSetTokenProfile creates a message to set the extended profile of a denom
+func NewMsgSetTokenProfile(sender, denom string, profile TokenProfile) *MsgSetTokenProfile {
+	return &MsgSetTokenProfile{
+		Sender:  sender,
+		Denom:   denom,
+		Profile: profile,
+	}
+}
+
+func (m MsgSetTokenProfile) Route() string { return RouterKey }
+func (m MsgSetTokenProfile) Type() string  { return TypeMsgSetTokenProfile }
+func (m MsgSetTokenProfile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return m.Profile.Validate()
+}
+
+func (m MsgSetTokenProfile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetTokenProfile) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMintVesting{}
+
+// NewMsgMintVesting creates a message to mint tokens into a vesting schedule
+func NewMsgMintVesting(sender, recipient string, amount sdk.Coin, startTime, cliffTime, endTime time.Time) *MsgMintVesting {
+	return &MsgMintVesting{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+	}
+}
+
+func (m MsgMintVesting) Route() string { return RouterKey }
+func (m MsgMintVesting) Type() string  { return TypeMsgMintVesting }
+func (m MsgMintVesting) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return ValidateVestingTimes(m.StartTime, m.CliffTime, m.EndTime)
+}
+
+func (m MsgMintVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMintVesting) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimVested{}
+
+// NewMsgClaimVested creates a message to claim the vested amounts of vesting schedules
+func NewMsgClaimVested(recipient string) *MsgClaimVested {
+	return &MsgClaimVested{
+		Recipient: recipient,
+	}
+}
+
+func (m MsgClaimVested) Route() string { return RouterKey }
+func (m MsgClaimVested) Type() string  { return TypeMsgClaimVested }
+func (m MsgClaimVested) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgClaimVested) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimVested) GetSigners() []sdk.AccAddress {
+	recipient, _ := sdk.AccAddressFromBech32(m.Recipient)
+	return []sdk.AccAddress{recipient}
+}
+
+var _ sdk.Msg = &MsgCreateMintSchedule{}
+
+// NewMsgCreateMintSchedule creates a message to create a recurring mint of a denom
+func NewMsgCreateMintSchedule(sender, recipient string, amount sdk.Coin, periodBlocks, periodSeconds, maxMints uint64, decayRate sdk.Dec) *MsgCreateMintSchedule {
+	return &MsgCreateMintSchedule{
+		Sender:        sender,
+		Recipient:     recipient,
+		Amount:        amount,
+		PeriodBlocks:  periodBlocks,
+		PeriodSeconds: periodSeconds,
+		MaxMints:      maxMints,
+		DecayRate:     decayRate,
+	}
+}
+
+func (m MsgCreateMintSchedule) Route() string { return RouterKey }
+func (m MsgCreateMintSchedule) Type() string  { return TypeMsgCreateMintSchedule }
+func (m MsgCreateMintSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	err = ValidateMintSchedulePeriod(m.PeriodBlocks, m.PeriodSeconds)
+	if err != nil {
+		return err
+	}
+
+	return ValidateMintScheduleDecayRate(m.DecayRate)
+}
+
+func (m MsgCreateMintSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCreateMintSchedule) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelMintSchedule{}
+
+// NewMsgCancelMintSchedule creates a message to cancel a mint schedule
+func NewMsgCancelMintSchedule(sender string, scheduleID uint64) *MsgCancelMintSchedule {
+	return &MsgCancelMintSchedule{
+		Sender:     sender,
+		ScheduleID: scheduleID,
+	}
+}
+
+func (m MsgCancelMintSchedule) Route() string { return RouterKey }
+func (m MsgCancelMintSchedule) Type() string  { return TypeMsgCancelMintSchedule }
+func (m MsgCancelMintSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgCancelMintSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelMintSchedule) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgTakeSnapshot{}
+
+// NewMsgTakeSnapshot creates a message to take a snapshot of the balances of a denom
+func NewMsgTakeSnapshot(sender, denom string) *MsgTakeSnapshot {
+	return &MsgTakeSnapshot{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgTakeSnapshot) Route() string { return RouterKey }
+func (m MsgTakeSnapshot) Type() string  { return TypeMsgTakeSnapshot }
+func (m MsgTakeSnapshot) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgTakeSnapshot) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTakeSnapshot) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgDepositDistribution{}
+
+// NewMsgDepositDistribution creates a message to distribute reward coins to the holders of a
+// denom
+func NewMsgDepositDistribution(sender, denom string, amount sdk.Coins) *MsgDepositDistribution {
+	return &MsgDepositDistribution{
+		Sender: sender,
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+func (m MsgDepositDistribution) Route() string { return RouterKey }
+func (m MsgDepositDistribution) Type() string  { return TypeMsgDepositDistribution }
+func (m MsgDepositDistribution) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	// the distributed denom would take part in its own distribution
+	if !m.Amount.AmountOf(m.Denom).IsZero() {
+		return errorsmod.Wrapf(ErrInvalidDistribution, "can't distribute %s to its own holders", m.Denom)
+	}
+
+	return nil
+}
+
+func (m MsgDepositDistribution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDepositDistribution) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimDistribution{}
+
+// NewMsgClaimDistribution creates a message to claim the distributed rewards of a holder of a
+// denom
+func NewMsgClaimDistribution(sender, denom string) *MsgClaimDistribution {
+	return &MsgClaimDistribution{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgClaimDistribution) Route() string { return RouterKey }
+func (m MsgClaimDistribution) Type() string  { return TypeMsgClaimDistribution }
+func (m MsgClaimDistribution) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgClaimDistribution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimDistribution) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetHolderIndex{}
+
+// NewMsgSetHolderIndex creates a message to enable or disable the holder index of a denom
+func NewMsgSetHolderIndex(sender, denom string, enabled bool) *MsgSetHolderIndex {
+	return &MsgSetHolderIndex{
+		Sender:  sender,
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+func (m MsgSetHolderIndex) Route() string { return RouterKey }
+func (m MsgSetHolderIndex) Type() string  { return TypeMsgSetHolderIndex }
+func (m MsgSetHolderIndex) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetHolderIndex) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetHolderIndex) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRegisterConversionRoute{}
+
+// NewMsgRegisterConversionRoute creates a message to let the holders of a denom convert it
+// into another denom. A nil deadline registers a route without a deadline.
+func NewMsgRegisterConversionRoute(sender, sourceDenom, targetDenom string, ratio sdk.Dec, deadline *time.Time) *MsgRegisterConversionRoute {
+	return &MsgRegisterConversionRoute{
+		Sender:      sender,
+		SourceDenom: sourceDenom,
+		TargetDenom: targetDenom,
+		Ratio:       ratio,
+		Deadline:    deadline,
+	}
+}
+
+func (m MsgRegisterConversionRoute) Route() string { return RouterKey }
+func (m MsgRegisterConversionRoute) Type() string  { return TypeMsgRegisterConversionRoute }
+func (m MsgRegisterConversionRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return ValidateConversionRoute(m.SourceDenom, m.TargetDenom, m.Ratio)
+}
+
+func (m MsgRegisterConversionRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRegisterConversionRoute) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgConvert{}
+
+// NewMsgConvert creates a message to convert an amount of a denom through its conversion
+// route
+func NewMsgConvert(sender string, amount sdk.Coin) *MsgConvert {
+	return &MsgConvert{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgConvert) Route() string { return RouterKey }
+func (m MsgConvert) Type() string  { return TypeMsgConvert }
+func (m MsgConvert) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.Amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgConvert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgConvert) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomBacking{}
+
+// NewMsgSetDenomBacking creates a message to back a denom by a collateral denom
+func NewMsgSetDenomBacking(sender, denom, collateralDenom string) *MsgSetDenomBacking {
+	return &MsgSetDenomBacking{
+		Sender:          sender,
+		Denom:           denom,
+		CollateralDenom: collateralDenom,
+	}
+}
+
+func (m MsgSetDenomBacking) Route() string { return RouterKey }
+func (m MsgSetDenomBacking) Type() string  { return TypeMsgSetDenomBacking }
+func (m MsgSetDenomBacking) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateCollateralDenom(m.CollateralDenom)
+}
+
+func (m MsgSetDenomBacking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomBacking) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWrap{}
+
+// NewMsgWrap creates a message to mint an amount of a backed denom against the same amount
+// of its collateral
+func NewMsgWrap(sender string, amount sdk.Coin) *MsgWrap {
+	return &MsgWrap{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgWrap) Route() string { return RouterKey }
+func (m MsgWrap) Type() string  { return TypeMsgWrap }
+func (m MsgWrap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.Amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgWrap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWrap) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUnwrap{}
+
+// NewMsgUnwrap creates a message to burn an amount of a backed denom and release the same
+// amount of its collateral
+func NewMsgUnwrap(sender string, amount sdk.Coin) *MsgUnwrap {
+	return &MsgUnwrap{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgUnwrap) Route() string { return RouterKey }
+func (m MsgUnwrap) Type() string  { return TypeMsgUnwrap }
+func (m MsgUnwrap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.Amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgUnwrap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUnwrap) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetTransferFee{}
+
+// NewMsgSetTransferFee creates a message to set the transfer fee of a denom
+func NewMsgSetTransferFee(sender, denom string, fee TransferFee) *MsgSetTransferFee {
+	return &MsgSetTransferFee{
+		Sender: sender,
+		Denom:  denom,
+		Fee:    fee,
+	}
+}
+
+func (m MsgSetTransferFee) Route() string { return RouterKey }
+func (m MsgSetTransferFee) Type() string  { return TypeMsgSetTransferFee }
+func (m MsgSetTransferFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	// a fee of zero basis points removes the transfer fee
+	if m.Fee.BasisPoints == 0 {
+		return nil
+	}
+	return m.Fee.Validate()
+}
+
+func (m MsgSetTransferFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetTransferFee) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetRestricted{}
+
+// NewMsgSetRestricted creates a message to restrict or unrestrict the transfers of a denom
+func NewMsgSetRestricted(sender, denom string, restricted bool) *MsgSetRestricted {
+	return &MsgSetRestricted{
+		Sender:     sender,
+		Denom:      denom,
+		Restricted: restricted,
+	}
+}
+
+func (m MsgSetRestricted) Route() string { return RouterKey }
+func (m MsgSetRestricted) Type() string  { return TypeMsgSetRestricted }
+func (m MsgSetRestricted) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetRestricted) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetRestricted) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAddToAllowlist{}
+
+// NewMsgAddToAllowlist creates a message to add addresses to the allowlist of a denom
+func NewMsgAddToAllowlist(sender, denom string, addresses []string) *MsgAddToAllowlist {
+	return &MsgAddToAllowlist{
+		Sender:    sender,
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgAddToAllowlist) Route() string { return RouterKey }
+func (m MsgAddToAllowlist) Type() string  { return TypeMsgAddToAllowlist }
+func (m MsgAddToAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return validateAllowlistUpdate(m.Addresses)
+}
+
+func (m MsgAddToAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddToAllowlist) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRemoveFromAllowlist{}
+
+// NewMsgRemoveFromAllowlist creates a message to remove addresses from the allowlist of a
+// denom
+func NewMsgRemoveFromAllowlist(sender, denom string, addresses []string) *MsgRemoveFromAllowlist {
+	return &MsgRemoveFromAllowlist{
+		Sender:    sender,
+		Denom:     denom,
+		Addresses: addresses,
+	}
+}
+
+func (m MsgRemoveFromAllowlist) Route() string { return RouterKey }
+func (m MsgRemoveFromAllowlist) Type() string  { return TypeMsgRemoveFromAllowlist }
+func (m MsgRemoveFromAllowlist) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return validateAllowlistUpdate(m.Addresses)
+}
+
+func (m MsgRemoveFromAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRemoveFromAllowlist) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMaxBalance{}
+
+// NewMsgSetMaxBalance creates a message to set the maximum balance per address of a denom
+func NewMsgSetMaxBalance(sender, denom string, maxBalance MaxBalance) *MsgSetMaxBalance {
+	return &MsgSetMaxBalance{
+		Sender:     sender,
+		Denom:      denom,
+		MaxBalance: maxBalance,
+	}
+}
+
+func (m MsgSetMaxBalance) Route() string { return RouterKey }
+func (m MsgSetMaxBalance) Type() string  { return TypeMsgSetMaxBalance }
+func (m MsgSetMaxBalance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	// a zero amount removes the max balance
+	if m.MaxBalance.Amount.IsNil() || m.MaxBalance.Amount.IsZero() {
+		return nil
+	}
+	return m.MaxBalance.Validate()
+}
+
+func (m MsgSetMaxBalance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxBalance) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgApprove{}
+
+// NewMsgApprove creates a message to let a spender transfer up to an amount of a denom from
+// the balance of the sender
+func NewMsgApprove(sender, spender string, amount sdk.Coin, expiration *time.Time) *MsgApprove {
+	return &MsgApprove{
+		Sender:     sender,
+		Spender:    spender,
+		Amount:     amount,
+		Expiration: expiration,
+	}
+}
+
+func (m MsgApprove) Route() string { return RouterKey }
+func (m MsgApprove) Type() string  { return TypeMsgApprove }
+func (m MsgApprove) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return ValidateAllowance(m.Sender, m.Spender, m.Amount)
+}
+
+func (m MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgApprove) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgTransferFrom{}
+
+// NewMsgTransferFrom creates a message to transfer an amount of a denom from the balance of
+// an owner within the allowance of the sender
+func NewMsgTransferFrom(sender, owner, recipient string, amount sdk.Coin) *MsgTransferFrom {
+	return &MsgTransferFrom{
+		Sender:    sender,
+		Owner:     owner,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+func (m MsgTransferFrom) Route() string { return RouterKey }
+func (m MsgTransferFrom) Type() string  { return TypeMsgTransferFrom }
+func (m MsgTransferFrom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(sdk.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	_, _, err = DeconstructDenom(m.Amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgTransferFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferFrom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeApproval{}
+
+// NewMsgRevokeApproval creates a message to remove the allowance of a spender for a denom
+func NewMsgRevokeApproval(sender, spender, denom string) *MsgRevokeApproval {
+	return &MsgRevokeApproval{
+		Sender:  sender,
+		Spender: spender,
+		Denom:   denom,
+	}
+}
+
+func (m MsgRevokeApproval) Route() string { return RouterKey }
+func (m MsgRevokeApproval) Type() string  { return TypeMsgRevokeApproval }
+func (m MsgRevokeApproval) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Spender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid spender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgRevokeApproval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeApproval) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -2,7 +2,9 @@ package types_test
 
 import (
 	fmt "fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -399,3 +401,909 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgUpdateReservedSubdenoms tests if valid/invalid update reserved subdenoms messages are properly validated/invalidated
+func TestMsgUpdateReservedSubdenoms(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper updateReservedSubdenoms message
+	baseMsg := types.NewMsgUpdateReservedSubdenoms(
+		addr1.String(),
+		[]string{"usdc", "atom*"},
+		[]string{"uosmo"},
+		[]string{addr1.String()},
+		nil,
+	)
+
+	// validate updateReservedSubdenoms message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "update_reserved_subdenoms")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgUpdateReservedSubdenoms
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgUpdateReservedSubdenoms {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty authority",
+			msg: func() *types.MsgUpdateReservedSubdenoms {
+				msg := *baseMsg
+				msg.Authority = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no updates",
+			msg: func() *types.MsgUpdateReservedSubdenoms {
+				return types.NewMsgUpdateReservedSubdenoms(addr1.String(), nil, nil, nil, nil)
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid pattern",
+			msg: func() *types.MsgUpdateReservedSubdenoms {
+				msg := *baseMsg
+				msg.AddPatterns = []string{"usd$"}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "pattern added and removed",
+			msg: func() *types.MsgUpdateReservedSubdenoms {
+				msg := *baseMsg
+				msg.RemovePatterns = []string{"usdc"}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid exempt creator",
+			msg: func() *types.MsgUpdateReservedSubdenoms {
+				msg := *baseMsg
+				msg.RemoveExemptCreators = []string{"invalid"}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgGovSetDenomAdmin(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr2.String())
+
+	// make a proper govSetDenomAdmin message
+	baseMsg := types.NewMsgGovSetDenomAdmin(addr1.String(), tokenFactoryDenom, addr2.String())
+
+	// validate govSetDenomAdmin message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "gov_set_denom_admin")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgGovSetDenomAdmin
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgGovSetDenomAdmin {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty new admin",
+			msg: func() *types.MsgGovSetDenomAdmin {
+				msg := *baseMsg
+				msg.NewAdmin = ""
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty authority",
+			msg: func() *types.MsgGovSetDenomAdmin {
+				msg := *baseMsg
+				msg.Authority = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid new admin",
+			msg: func() *types.MsgGovSetDenomAdmin {
+				msg := *baseMsg
+				msg.NewAdmin = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgGovSetDenomAdmin {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgGovFreezeDenom(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper govFreezeDenom message
+	baseMsg := types.NewMsgGovFreezeDenom(addr1.String(), tokenFactoryDenom, true)
+
+	// validate govFreezeDenom message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "gov_freeze_denom")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgGovFreezeDenom
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgGovFreezeDenom {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty authority",
+			msg: func() *types.MsgGovFreezeDenom {
+				msg := *baseMsg
+				msg.Authority = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgGovFreezeDenom {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgLockDenomMetadata(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper lockDenomMetadata message
+	baseMsg := types.NewMsgLockDenomMetadata(addr1.String(), tokenFactoryDenom, true)
+
+	// validate lockDenomMetadata message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "lock_denom_metadata")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgLockDenomMetadata
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgLockDenomMetadata {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgLockDenomMetadata {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgLockDenomMetadata {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetTokenProfile(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setTokenProfile message
+	baseMsg := types.NewMsgSetTokenProfile(addr1.String(), tokenFactoryDenom, types.TokenProfile{
+		LogoURI:     "https://bitcoin.org/logo.svg",
+		LogoURIHash: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Website:     "https://bitcoin.org",
+		Tags:        []string{"payments"},
+	})
+
+	// validate setTokenProfile message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_token_profile")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetTokenProfile
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty profile",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				msg.Profile = types.TokenProfile{}
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "logo URI hash that is not sha256",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				msg.Profile.LogoURIHash = "e3b0c442"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "logo URI hash without logo URI",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				msg.Profile.LogoURI = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "website too long",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				msg.Profile.Website = "https://" + strings.Repeat("a", types.MaxTokenProfileURILength)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate tag",
+			msg: func() *types.MsgSetTokenProfile {
+				msg := *baseMsg
+				msg.Profile.Tags = []string{"payments", "payments"}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgMintVesting(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// make a proper mintVesting message
+	baseMsg := types.NewMsgMintVesting(addr1.String(), addr2.String(), sdk.NewInt64Coin("bitcoin", 500000000), startTime, startTime.Add(time.Hour), startTime.Add(2*time.Hour))
+
+	// validate mintVesting message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "mint_vesting")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgMintVesting
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgMintVesting {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty recipient",
+			msg: func() *types.MsgMintVesting {
+				msg := *baseMsg
+				msg.Recipient = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgMintVesting {
+				msg := *baseMsg
+				msg.Amount = sdk.NewInt64Coin("bitcoin", 0)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "end time before cliff time",
+			msg: func() *types.MsgMintVesting {
+				msg := *baseMsg
+				msg.EndTime = startTime
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateMintSchedule(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+
+	// make a proper createMintSchedule message
+	baseMsg := types.NewMsgCreateMintSchedule(addr1.String(), addr2.String(), sdk.NewInt64Coin("bitcoin", 500000000), 100, 0, 12, sdk.NewDecWithPrec(1, 1))
+
+	// validate createMintSchedule message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "create_mint_schedule")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgCreateMintSchedule
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "period in seconds",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.PeriodBlocks = 0
+				msg.PeriodSeconds = 3600
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty recipient",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.Recipient = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.Amount = sdk.NewInt64Coin("bitcoin", 0)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no period",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.PeriodBlocks = 0
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "both periods",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.PeriodSeconds = 3600
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "decay rate of one",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.DecayRate = sdk.OneDec()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative decay rate",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.DecayRate = sdk.NewDec(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil decay rate means no decay",
+			msg: func() *types.MsgCreateMintSchedule {
+				msg := *baseMsg
+				msg.DecayRate = sdk.Dec{}
+				return &msg
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgTakeSnapshot(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper takeSnapshot message
+	denom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	baseMsg := types.NewMsgTakeSnapshot(addr1.String(), denom)
+
+	// validate takeSnapshot message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "take_snapshot")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgTakeSnapshot
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgTakeSnapshot {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgTakeSnapshot {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "non-factory denom",
+			msg: func() *types.MsgTakeSnapshot {
+				msg := *baseMsg
+				msg.Denom = "uosmo"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgDepositDistribution(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper depositDistribution message
+	denom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	baseMsg := types.NewMsgDepositDistribution(addr1.String(), denom, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)))
+
+	// validate depositDistribution message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "deposit_distribution")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgDepositDistribution
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgDepositDistribution {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "non-factory denom",
+			msg: func() *types.MsgDepositDistribution {
+				msg := *baseMsg
+				msg.Denom = "uosmo"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty amount",
+			msg: func() *types.MsgDepositDistribution {
+				msg := *baseMsg
+				msg.Amount = sdk.NewCoins()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "distributing the denom itself",
+			msg: func() *types.MsgDepositDistribution {
+				msg := *baseMsg
+				msg.Amount = sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRegisterConversionRoute(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper registerConversionRoute message
+	sourceDenom := fmt.Sprintf("factory/%s/v1", addr1.String())
+	targetDenom := fmt.Sprintf("factory/%s/v2", addr1.String())
+	baseMsg := types.NewMsgRegisterConversionRoute(addr1.String(), sourceDenom, targetDenom, sdk.OneDec(), nil)
+
+	// validate registerConversionRoute message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "register_conversion_route")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgRegisterConversionRoute
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgRegisterConversionRoute {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "non-factory target denom",
+			msg: func() *types.MsgRegisterConversionRoute {
+				msg := *baseMsg
+				msg.TargetDenom = "uosmo"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "same source and target denoms",
+			msg: func() *types.MsgRegisterConversionRoute {
+				msg := *baseMsg
+				msg.TargetDenom = sourceDenom
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero ratio",
+			msg: func() *types.MsgRegisterConversionRoute {
+				msg := *baseMsg
+				msg.Ratio = sdk.ZeroDec()
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgAddToAllowlist(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper addToAllowlist message
+	denom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	baseMsg := types.NewMsgAddToAllowlist(addr1.String(), denom, []string{addr1.String()})
+
+	// validate addToAllowlist message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "add_to_allowlist")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgAddToAllowlist
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgAddToAllowlist {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "no addresses",
+			msg: func() *types.MsgAddToAllowlist {
+				msg := *baseMsg
+				msg.Addresses = nil
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate address",
+			msg: func() *types.MsgAddToAllowlist {
+				msg := *baseMsg
+				msg.Addresses = []string{addr1.String(), addr1.String()}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "too many addresses",
+			msg: func() *types.MsgAddToAllowlist {
+				msg := *baseMsg
+				msg.Addresses = []string{}
+				for i := 0; i <= types.MaxAllowlistAddressesPerMsg; i++ {
+					msg.Addresses = append(msg.Addresses, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String())
+				}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgApprove(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+
+	// make a proper approve message
+	denom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	baseMsg := types.NewMsgApprove(addr1.String(), addr2.String(), sdk.NewInt64Coin(denom, 100), nil)
+
+	// validate approve message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "approve")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgApprove
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgApprove {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "spender is the sender",
+			msg: func() *types.MsgApprove {
+				msg := *baseMsg
+				msg.Spender = addr1.String()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgApprove {
+				msg := *baseMsg
+				msg.Amount = sdk.NewInt64Coin(denom, 0)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "non-factory denom",
+			msg: func() *types.MsgApprove {
+				msg := *baseMsg
+				msg.Amount = sdk.NewInt64Coin("uosmo", 100)
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -11,9 +12,30 @@ import (
 var (
 	KeyDenomCreationFee        = []byte("DenomCreationFee")
 	KeyDenomCreationGasConsume = []byte("DenomCreationGasConsume")
+	KeyReservedSubdenoms       = []byte("ReservedSubdenoms")
+	KeyMaxDenomsPerCreator     = []byte("MaxDenomsPerCreator")
+	KeyMaxCreationsPerWindow   = []byte("MaxCreationsPerWindow")
+	KeyCreationWindowBlocks    = []byte("CreationWindowBlocks")
+
+	KeyDenomCreationFeeIsDeposit = []byte("DenomCreationFeeIsDeposit")
+	KeyDenomUnitPatterns         = []byte("DenomUnitPatterns")
+	KeyReservedSymbols           = []byte("ReservedSymbols")
+	KeyMaxMintSchedulesPerBlock  = []byte("MaxMintSchedulesPerBlock")
+
+	KeyReservedSubdenomExemptCreators = []byte("ReservedSubdenomExemptCreators")
+	KeyMaxMintSchedulesPerDenom       = []byte("MaxMintSchedulesPerDenom")
+
+	// the maximum length of a denom in the bank module.
+	MaxDenomUnitPatternLength = 128
 
 	// chosen as an arbitrary large number, less than the max_gas_wanted_per_tx in config.
 	DefaultCreationGasFee = 1_000_000
+
+	// bounds the work done in EndBlock for mint schedules.
+	DefaultMaxMintSchedulesPerBlock = 100
+
+	// bounds the mint schedules an admin can add to EndBlock for a single denom.
+	DefaultMaxMintSchedulesPerDenom = 10
 )
 
 // ParamTable for gamm module.
@@ -21,10 +43,35 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume uint64) Params {
+func NewParams(
+	denomCreationFee sdk.Coins,
+	denomCreationGasConsume uint64,
+	reservedSubdenoms []string,
+	maxDenomsPerCreator uint64,
+	maxCreationsPerWindow uint64,
+	creationWindowBlocks uint64,
+	denomCreationFeeIsDeposit bool,
+	denomUnitPatterns []string,
+	reservedSymbols []string,
+	maxMintSchedulesPerBlock uint64,
+	reservedSubdenomExemptCreators []string,
+	maxMintSchedulesPerDenom uint64,
+) Params {
 	return Params{
 		DenomCreationFee:        denomCreationFee,
 		DenomCreationGasConsume: denomCreationGasConsume,
+		ReservedSubdenoms:       reservedSubdenoms,
+		MaxDenomsPerCreator:     maxDenomsPerCreator,
+		MaxCreationsPerWindow:   maxCreationsPerWindow,
+		CreationWindowBlocks:    creationWindowBlocks,
+
+		DenomCreationFeeIsDeposit: denomCreationFeeIsDeposit,
+		DenomUnitPatterns:         denomUnitPatterns,
+		ReservedSymbols:           reservedSymbols,
+		MaxMintSchedulesPerBlock:  maxMintSchedulesPerBlock,
+
+		ReservedSubdenomExemptCreators: reservedSubdenomExemptCreators,
+		MaxMintSchedulesPerDenom:       maxMintSchedulesPerDenom,
 	}
 }
 
@@ -34,6 +81,22 @@ func DefaultParams() Params {
 		// For choice, see: https://github.com/osmosis-labs/osmosis/pull/4983
 		DenomCreationFee:        sdk.NewCoins(), // used to be 10 OSMO at launch.
 		DenomCreationGasConsume: uint64(DefaultCreationGasFee),
+		ReservedSubdenoms:       []string{},
+		// the number of denoms and the creation rate are not limited by default.
+		MaxDenomsPerCreator:   0,
+		MaxCreationsPerWindow: 0,
+		CreationWindowBlocks:  0,
+		// the denom creation fee is transferred to the community pool by default.
+		DenomCreationFeeIsDeposit: false,
+		// denom units must be namespaced under the base denom by default.
+		DenomUnitPatterns: []string{},
+		ReservedSymbols:   []string{},
+		// at most this many mint schedules are visited in EndBlock.
+		MaxMintSchedulesPerBlock: uint64(DefaultMaxMintSchedulesPerBlock),
+		// no creator is exempt from the reserved subdenoms by default.
+		ReservedSubdenomExemptCreators: []string{},
+		// at most this many mint schedules can be pending for a denom.
+		MaxMintSchedulesPerDenom: uint64(DefaultMaxMintSchedulesPerDenom),
 	}
 }
 
@@ -43,6 +106,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateReservedSubdenoms(p.ReservedSubdenoms); err != nil {
+		return err
+	}
+
+	if err := validateReservedSubdenomExemptCreators(p.ReservedSubdenomExemptCreators); err != nil {
+		return err
+	}
+
+	if err := validateDenomUnitPatterns(p.DenomUnitPatterns); err != nil {
+		return err
+	}
+
+	if err := validateReservedSymbols(p.ReservedSymbols); err != nil {
+		return err
+	}
+
+	if p.MaxCreationsPerWindow != 0 && p.CreationWindowBlocks == 0 {
+		return fmt.Errorf("creation window blocks must be positive when max creations per window is set")
+	}
+
 	return nil
 }
 
@@ -51,6 +134,16 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
 		paramtypes.NewParamSetPair(KeyDenomCreationGasConsume, &p.DenomCreationGasConsume, validateDenomCreationGasConsume),
+		paramtypes.NewParamSetPair(KeyReservedSubdenoms, &p.ReservedSubdenoms, validateReservedSubdenoms),
+		paramtypes.NewParamSetPair(KeyMaxDenomsPerCreator, &p.MaxDenomsPerCreator, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxCreationsPerWindow, &p.MaxCreationsPerWindow, validateUint64),
+		paramtypes.NewParamSetPair(KeyCreationWindowBlocks, &p.CreationWindowBlocks, validateUint64),
+		paramtypes.NewParamSetPair(KeyDenomCreationFeeIsDeposit, &p.DenomCreationFeeIsDeposit, validateBool),
+		paramtypes.NewParamSetPair(KeyDenomUnitPatterns, &p.DenomUnitPatterns, validateDenomUnitPatterns),
+		paramtypes.NewParamSetPair(KeyReservedSymbols, &p.ReservedSymbols, validateReservedSymbols),
+		paramtypes.NewParamSetPair(KeyMaxMintSchedulesPerBlock, &p.MaxMintSchedulesPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyReservedSubdenomExemptCreators, &p.ReservedSubdenomExemptCreators, validateReservedSubdenomExemptCreators),
+		paramtypes.NewParamSetPair(KeyMaxMintSchedulesPerDenom, &p.MaxMintSchedulesPerDenom, validateUint64),
 	}
 }
 
@@ -75,3 +168,110 @@ func validateDenomCreationGasConsume(i interface{}) error {
 
 	return nil
 }
+
+func validateReservedSubdenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPatterns := map[string]bool{}
+	for _, pattern := range v {
+		if err := ValidateReservedSubdenomPattern(pattern); err != nil {
+			return err
+		}
+		if seenPatterns[pattern] {
+			return fmt.Errorf("duplicate reserved subdenom: %s", pattern)
+		}
+		seenPatterns[pattern] = true
+	}
+
+	return nil
+}
+
+func validateReservedSubdenomExemptCreators(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenCreators := map[string]bool{}
+	for _, creator := range v {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return fmt.Errorf("invalid reserved subdenom exempt creator: %w", err)
+		}
+		if seenCreators[creator] {
+			return fmt.Errorf("duplicate reserved subdenom exempt creator: %s", creator)
+		}
+		seenCreators[creator] = true
+	}
+
+	return nil
+}
+
+func validateDenomUnitPatterns(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPatterns := map[string]bool{}
+	for _, pattern := range v {
+		if strings.Trim(pattern, ReservedSubdenomWildcard) == "" {
+			return fmt.Errorf("denom unit pattern %q must match less than every denom", pattern)
+		}
+		if len(pattern) > MaxDenomUnitPatternLength {
+			return fmt.Errorf("denom unit pattern %s is longer than %d bytes", pattern, MaxDenomUnitPatternLength)
+		}
+		for _, c := range pattern {
+			isAlphanumeric := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+			if !isAlphanumeric && !strings.ContainsRune("/:._-"+ReservedSubdenomWildcard, c) {
+				return fmt.Errorf("denom unit pattern %s contains invalid character %q", pattern, c)
+			}
+		}
+		if seenPatterns[pattern] {
+			return fmt.Errorf("duplicate denom unit pattern: %s", pattern)
+		}
+		seenPatterns[pattern] = true
+	}
+
+	return nil
+}
+
+func validateReservedSymbols(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenSymbols := map[string]bool{}
+	for _, symbol := range v {
+		if symbol == "" {
+			return fmt.Errorf("reserved symbol cannot be empty")
+		}
+		if seenSymbols[strings.ToUpper(symbol)] {
+			return fmt.Errorf("duplicate reserved symbol: %s", symbol)
+		}
+		seenSymbols[strings.ToUpper(symbol)] = true
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
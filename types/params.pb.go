@@ -37,6 +37,45 @@ type Params struct {
 	//
 	// See: https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// ReservedSubdenoms defines the subdenom patterns, with * as a wildcard,
+	// that can not be used when creating a new denom, such as the base denoms of
+	// native and IBC assets. A pattern without a wildcard reserves a subdenom
+	// exactly.
+	ReservedSubdenoms []string `protobuf:"bytes,3,rep,name=reserved_subdenoms,json=reservedSubdenoms,proto3" json:"reserved_subdenoms,omitempty" yaml:"reserved_subdenoms"`
+	// MaxDenomsPerCreator defines the maximum number of denoms a single address
+	// can create. Zero means there is no limit.
+	MaxDenomsPerCreator uint64 `protobuf:"varint,4,opt,name=max_denoms_per_creator,json=maxDenomsPerCreator,proto3" json:"max_denoms_per_creator,omitempty" yaml:"max_denoms_per_creator"`
+	// MaxCreationsPerWindow defines the maximum number of denoms a single
+	// address can create within CreationWindowBlocks blocks. Zero means there is
+	// no limit.
+	MaxCreationsPerWindow uint64 `protobuf:"varint,5,opt,name=max_creations_per_window,json=maxCreationsPerWindow,proto3" json:"max_creations_per_window,omitempty" yaml:"max_creations_per_window"`
+	// CreationWindowBlocks defines the length in blocks of the window in which
+	// MaxCreationsPerWindow applies.
+	CreationWindowBlocks uint64 `protobuf:"varint,6,opt,name=creation_window_blocks,json=creationWindowBlocks,proto3" json:"creation_window_blocks,omitempty" yaml:"creation_window_blocks"`
+	// DenomCreationFeeIsDeposit defines whether the DenomCreationFee is held in
+	// escrow by the module account as a deposit, instead of being transferred to
+	// the community pool. The deposit is refunded when the denom is deleted, and
+	// transferred to the community pool when governance delists the denom.
+	DenomCreationFeeIsDeposit bool `protobuf:"varint,7,opt,name=denom_creation_fee_is_deposit,json=denomCreationFeeIsDeposit,proto3" json:"denom_creation_fee_is_deposit,omitempty" yaml:"denom_creation_fee_is_deposit"`
+	// DenomUnitPatterns defines the patterns, with * as a wildcard, that the
+	// non-base denom units and aliases of the metadata of a denom can match
+	// instead of being namespaced under the base denom, as in
+	// factory/{creator}/{subdenom}/{unit}.
+	DenomUnitPatterns []string `protobuf:"bytes,8,rep,name=denom_unit_patterns,json=denomUnitPatterns,proto3" json:"denom_unit_patterns,omitempty" yaml:"denom_unit_patterns"`
+	// ReservedSymbols defines the symbols, compared case-insensitively, that
+	// can not be used in the metadata of a denom, such as the symbols of native
+	// and well-known assets.
+	ReservedSymbols []string `protobuf:"bytes,9,rep,name=reserved_symbols,json=reservedSymbols,proto3" json:"reserved_symbols,omitempty" yaml:"reserved_symbols"`
+	// MaxMintSchedulesPerBlock defines the maximum number of mint schedules
+	// visited in EndBlock. Schedules that are not visited in a block are visited
+	// in the following blocks. Zero disables the execution of mint schedules.
+	MaxMintSchedulesPerBlock uint64 `protobuf:"varint,10,opt,name=max_mint_schedules_per_block,json=maxMintSchedulesPerBlock,proto3" json:"max_mint_schedules_per_block,omitempty" yaml:"max_mint_schedules_per_block"`
+	// ReservedSubdenomExemptCreators defines the creators that can create denoms
+	// with reserved subdenoms.
+	ReservedSubdenomExemptCreators []string `protobuf:"bytes,11,rep,name=reserved_subdenom_exempt_creators,json=reservedSubdenomExemptCreators,proto3" json:"reserved_subdenom_exempt_creators,omitempty" yaml:"reserved_subdenom_exempt_creators"`
+	// MaxMintSchedulesPerDenom defines the maximum number of pending mint
+	// schedules of a denom. Zero means there is no limit.
+	MaxMintSchedulesPerDenom uint64 `protobuf:"varint,12,opt,name=max_mint_schedules_per_denom,json=maxMintSchedulesPerDenom,proto3" json:"max_mint_schedules_per_denom,omitempty" yaml:"max_mint_schedules_per_denom"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -86,6 +125,76 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetReservedSubdenoms() []string {
+	if m != nil {
+		return m.ReservedSubdenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDenomsPerCreator() uint64 {
+	if m != nil {
+		return m.MaxDenomsPerCreator
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCreationsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxCreationsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetCreationWindowBlocks() uint64 {
+	if m != nil {
+		return m.CreationWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDenomCreationFeeIsDeposit() bool {
+	if m != nil {
+		return m.DenomCreationFeeIsDeposit
+	}
+	return false
+}
+
+func (m *Params) GetDenomUnitPatterns() []string {
+	if m != nil {
+		return m.DenomUnitPatterns
+	}
+	return nil
+}
+
+func (m *Params) GetReservedSymbols() []string {
+	if m != nil {
+		return m.ReservedSymbols
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMintSchedulesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxMintSchedulesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetReservedSubdenomExemptCreators() []string {
+	if m != nil {
+		return m.ReservedSubdenomExemptCreators
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMintSchedulesPerDenom() uint64 {
+	if m != nil {
+		return m.MaxMintSchedulesPerDenom
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "tokenfactory.v1beta1.Params")
 }
@@ -93,29 +202,51 @@ func init() {
 func init() { proto.RegisterFile("tokenfactory/v1beta1/params.proto", fileDescriptor_4d491a2fda25be4d) }
 
 var fileDescriptor_4d491a2fda25be4d = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xfa, 0x40,
-	0x1c, 0xc7, 0x7b, 0xfc, 0xff, 0x61, 0xa8, 0x8b, 0x69, 0x48, 0x04, 0x86, 0x2b, 0x74, 0x42, 0x23,
-	0x6d, 0xd0, 0xcd, 0x11, 0x8c, 0x4e, 0x24, 0x86, 0xd1, 0xa5, 0xf9, 0xb5, 0x1c, 0xa5, 0x81, 0xf6,
-	0x47, 0x7a, 0x87, 0x49, 0x1f, 0xc1, 0xcd, 0xc9, 0x87, 0xf0, 0x49, 0x18, 0x19, 0x9d, 0xaa, 0x81,
-	0x37, 0xe0, 0x09, 0x0c, 0x77, 0xa7, 0x29, 0xca, 0xd4, 0xfe, 0xf2, 0xfd, 0xde, 0xe7, 0x3e, 0x77,
-	0x67, 0xb6, 0x05, 0xce, 0x58, 0x3a, 0x81, 0x50, 0x60, 0x96, 0x7b, 0x4f, 0xbd, 0x80, 0x09, 0xe8,
-	0x79, 0x0b, 0xc8, 0x20, 0xe1, 0xee, 0x22, 0x43, 0x81, 0x56, 0xad, 0x5c, 0x71, 0x75, 0xa5, 0x59,
-	0x8b, 0x30, 0x42, 0x59, 0xf0, 0xf6, 0x7f, 0xaa, 0xdb, 0xbc, 0x3c, 0x8a, 0x83, 0xa5, 0x98, 0x62,
-	0x16, 0x8b, 0x7c, 0xc8, 0x04, 0x8c, 0x41, 0x80, 0x6e, 0x37, 0x42, 0xe4, 0x09, 0x72, 0x5f, 0x61,
-	0xd4, 0xa0, 0x23, 0xaa, 0x26, 0x2f, 0x00, 0xce, 0x7e, 0x38, 0x21, 0xc6, 0xa9, 0xca, 0x9d, 0xe7,
-	0x8a, 0x59, 0x7d, 0x90, 0x96, 0xd6, 0x2b, 0x31, 0xad, 0x31, 0x4b, 0x31, 0xf1, 0xc3, 0x8c, 0x81,
-	0x88, 0x31, 0xf5, 0x27, 0x8c, 0xd5, 0x49, 0xeb, 0x5f, 0xe7, 0xe4, 0xaa, 0xe1, 0x6a, 0xec, 0x1e,
-	0xf4, 0x2d, 0xef, 0x0e, 0x30, 0x4e, 0xfb, 0xc3, 0x55, 0x61, 0x1b, 0xbb, 0xc2, 0x6e, 0xe4, 0x90,
-	0xcc, 0x6f, 0x9c, 0xbf, 0x08, 0xe7, 0xed, 0xc3, 0xee, 0x44, 0xb1, 0x98, 0x2e, 0x03, 0x37, 0xc4,
-	0x44, 0x0b, 0xea, 0x4f, 0x97, 0x8f, 0x67, 0x9e, 0xc8, 0x17, 0x8c, 0x4b, 0x1a, 0x1f, 0x9d, 0x4a,
-	0xc0, 0x40, 0xaf, 0xbf, 0x63, 0xcc, 0x9a, 0x98, 0xcd, 0x5f, 0xd0, 0x08, 0xb8, 0x1f, 0x62, 0xca,
-	0x97, 0x09, 0xab, 0x57, 0x5a, 0xa4, 0xf3, 0xbf, 0x7f, 0xbe, 0x2a, 0x6c, 0xb2, 0x2b, 0xec, 0xf6,
-	0x51, 0x89, 0x52, 0xdf, 0x19, 0x9d, 0x1d, 0x6c, 0x70, 0x0f, 0x7c, 0xa0, 0x92, 0xfe, 0xed, 0x6a,
-	0x43, 0xc9, 0x7a, 0x43, 0xc9, 0xe7, 0x86, 0x92, 0x97, 0x2d, 0x35, 0xd6, 0x5b, 0x6a, 0xbc, 0x6f,
-	0xa9, 0xf1, 0x78, 0x51, 0xb2, 0x97, 0xd6, 0x31, 0xef, 0xce, 0x21, 0xe0, 0xde, 0xc1, 0x33, 0xc9,
-	0x53, 0x04, 0x55, 0x79, 0xb1, 0xd7, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x53, 0x6a, 0x05,
-	0x12, 0x02, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x86, 0xe3, 0x0b, 0x97, 0x0b, 0xc3, 0x95, 0x2e, 0x0c, 0x5c, 0x70, 0xd2, 0x62, 0x27, 0x43,
+	0xa5, 0xba, 0x15, 0x24, 0xa2, 0xdd, 0x75, 0x99, 0x50, 0xaa, 0x4a, 0xa5, 0x8a, 0x8c, 0x5a, 0xa4,
+	0xaa, 0xd2, 0x68, 0x6c, 0x0f, 0x61, 0x4a, 0xec, 0xb1, 0x3c, 0x13, 0x48, 0xde, 0xa2, 0xab, 0x3e,
+	0x44, 0xf7, 0x7d, 0x07, 0x96, 0x2c, 0xbb, 0x72, 0x2b, 0x78, 0x03, 0x3f, 0x41, 0x95, 0x19, 0x9b,
+	0x84, 0x24, 0x54, 0x5d, 0xc1, 0x9c, 0xf3, 0xcd, 0xef, 0x73, 0xe6, 0xfc, 0x39, 0xa0, 0x26, 0xf9,
+	0x19, 0x8d, 0x4e, 0x88, 0x2f, 0x79, 0x32, 0x68, 0x9c, 0xef, 0x79, 0x54, 0x92, 0xbd, 0x46, 0x4c,
+	0x12, 0x12, 0x8a, 0x7a, 0x9c, 0x70, 0xc9, 0xe1, 0xfa, 0x38, 0x52, 0xcf, 0x91, 0xca, 0x7a, 0x87,
+	0x77, 0xb8, 0x02, 0x1a, 0xc3, 0xff, 0x34, 0x5b, 0xd9, 0x99, 0x29, 0x47, 0x7a, 0xf2, 0x94, 0x27,
+	0x4c, 0x0e, 0x0e, 0xa9, 0x24, 0x01, 0x91, 0x24, 0xa7, 0xcb, 0x3e, 0x17, 0x21, 0x17, 0x58, 0xcb,
+	0xe8, 0x43, 0x9e, 0xb2, 0xf4, 0xa9, 0xe1, 0x11, 0x41, 0x6f, 0x75, 0x7c, 0xce, 0x22, 0x9d, 0x47,
+	0xdf, 0x96, 0xc0, 0x42, 0x5b, 0x55, 0x09, 0xbf, 0x18, 0x00, 0x06, 0x34, 0xe2, 0x21, 0xf6, 0x13,
+	0x4a, 0x24, 0xe3, 0x11, 0x3e, 0xa1, 0xd4, 0x34, 0xaa, 0x73, 0xce, 0xf2, 0xb3, 0x72, 0x3d, 0x97,
+	0x1d, 0x0a, 0x15, 0xc5, 0xd7, 0x5b, 0x9c, 0x45, 0xcd, 0xc3, 0xcb, 0xd4, 0x2e, 0x65, 0xa9, 0x5d,
+	0x1e, 0x90, 0xb0, 0xfb, 0x02, 0x4d, 0x4b, 0xa0, 0xaf, 0x3f, 0x6c, 0xa7, 0xc3, 0xe4, 0x69, 0xcf,
+	0xab, 0xfb, 0x3c, 0xcc, 0x0b, 0xcc, 0xff, 0xec, 0x8a, 0xe0, 0xac, 0x21, 0x07, 0x31, 0x15, 0x4a,
+	0x4d, 0xb8, 0x2b, 0x4a, 0xa0, 0x95, 0xdf, 0x3f, 0xa0, 0x14, 0x9e, 0x80, 0xca, 0x84, 0x68, 0x87,
+	0x08, 0xec, 0xf3, 0x48, 0xf4, 0x42, 0x6a, 0xfe, 0x55, 0x35, 0x9c, 0xf9, 0xe6, 0x93, 0xcb, 0xd4,
+	0x36, 0xb2, 0xd4, 0xae, 0xcd, 0x2c, 0x62, 0x8c, 0x47, 0xee, 0xe6, 0x9d, 0x0f, 0xbc, 0x22, 0xa2,
+	0xa5, 0x33, 0xf0, 0x0d, 0x80, 0x09, 0x15, 0x34, 0x39, 0xa7, 0x01, 0x16, 0x3d, 0x4f, 0x61, 0xc2,
+	0x9c, 0xab, 0xce, 0x39, 0x4b, 0xcd, 0xad, 0x51, 0x83, 0xd3, 0x0c, 0x72, 0x57, 0x8b, 0xe0, 0x51,
+	0x11, 0x83, 0xef, 0xc1, 0x46, 0x48, 0xfa, 0x58, 0x9f, 0x70, 0x4c, 0x13, 0x5d, 0x0e, 0x4f, 0xcc,
+	0x79, 0x55, 0x71, 0x2d, 0x4b, 0xed, 0x2d, 0xad, 0x38, 0x9b, 0x43, 0xee, 0x5a, 0x48, 0xfa, 0xfb,
+	0x2a, 0xde, 0xa6, 0x49, 0x4b, 0x47, 0xe1, 0x47, 0x60, 0x0e, 0xf9, 0xa2, 0x37, 0x7d, 0xe5, 0x82,
+	0x45, 0x01, 0xbf, 0x30, 0xff, 0x56, 0xca, 0xdb, 0x59, 0x6a, 0xdb, 0x23, 0xe5, 0x59, 0x24, 0x72,
+	0xff, 0x0f, 0x49, 0xbf, 0x78, 0x83, 0xa1, 0xfc, 0xb1, 0x8a, 0xc3, 0x63, 0xb0, 0x71, 0xfb, 0x6a,
+	0x1a, 0xc5, 0x5e, 0x97, 0xfb, 0x67, 0xc2, 0x5c, 0x98, 0xac, 0x7a, 0x36, 0x87, 0xdc, 0xf5, 0x22,
+	0xa1, 0x25, 0x9b, 0x2a, 0x0c, 0x3f, 0x81, 0xad, 0x69, 0x67, 0x60, 0x26, 0x70, 0x40, 0x63, 0x2e,
+	0x98, 0x34, 0xff, 0xa9, 0x1a, 0xce, 0x62, 0xd3, 0xc9, 0x52, 0xfb, 0xd1, 0x7d, 0x46, 0x1a, 0xc3,
+	0x91, 0x5b, 0x9e, 0xf4, 0xc9, 0x6b, 0xb1, 0xaf, 0x73, 0xf0, 0x2d, 0x58, 0xd3, 0x97, 0x7b, 0x11,
+	0x93, 0x38, 0x26, 0x52, 0xd2, 0x24, 0x12, 0xe6, 0xa2, 0x9a, 0xa4, 0x95, 0xa5, 0x76, 0x65, 0xfc,
+	0x0b, 0x77, 0x20, 0xe4, 0xae, 0xaa, 0xe8, 0xbb, 0x88, 0xc9, 0x76, 0x1e, 0x83, 0x07, 0x60, 0x65,
+	0x34, 0xf4, 0x41, 0xe8, 0xf1, 0xae, 0x30, 0x97, 0x94, 0xd8, 0x83, 0x2c, 0xb5, 0x37, 0x27, 0x6d,
+	0xa1, 0x09, 0xe4, 0xfe, 0x77, 0x6b, 0x0a, 0x1d, 0x81, 0x1d, 0xf0, 0x70, 0x38, 0x90, 0x90, 0x45,
+	0x12, 0x0b, 0xff, 0x94, 0x06, 0xbd, 0x2e, 0xd5, 0x53, 0x51, 0x6f, 0x67, 0x02, 0xf5, 0xc4, 0x8f,
+	0xb3, 0xd4, 0xde, 0x1e, 0x8d, 0xef, 0x3e, 0x1a, 0xb9, 0x43, 0x1f, 0x1c, 0xb2, 0x48, 0x1e, 0x15,
+	0xc9, 0x36, 0x4d, 0xd4, 0x6b, 0xc3, 0x0b, 0x50, 0x9b, 0x72, 0x29, 0xa6, 0x7d, 0x1a, 0xc6, 0xb2,
+	0x70, 0x97, 0x30, 0x97, 0x55, 0x07, 0x3b, 0x59, 0x6a, 0x3b, 0xf7, 0x18, 0x7b, 0xf2, 0x0a, 0x72,
+	0xad, 0x49, 0x9f, 0xbf, 0x54, 0x44, 0xee, 0xcd, 0xdf, 0x75, 0xa8, 0x68, 0xf3, 0xdf, 0x3f, 0xec,
+	0x50, 0xd1, 0xb3, 0x3b, 0x54, 0x3f, 0x88, 0xe6, 0xfe, 0xe5, 0xb5, 0x65, 0x5c, 0x5d, 0x5b, 0xc6,
+	0xcf, 0x6b, 0xcb, 0xf8, 0x7c, 0x63, 0x95, 0xae, 0x6e, 0xac, 0xd2, 0xf7, 0x1b, 0xab, 0xf4, 0xe1,
+	0xe9, 0xd8, 0xa6, 0x51, 0x1b, 0x86, 0x89, 0xdd, 0x2e, 0xf1, 0x44, 0xe3, 0xce, 0x4a, 0x55, 0x1b,
+	0xc7, 0x5b, 0x50, 0x4b, 0xf0, 0xf9, 0xaf, 0x01, 0x00, 0xbe, 0x91, 0x1a, 0x4a, 0xbe, 0x05, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +269,77 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMintSchedulesPerDenom != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMintSchedulesPerDenom))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ReservedSubdenomExemptCreators) > 0 {
+		for iNdEx := len(m.ReservedSubdenomExemptCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedSubdenomExemptCreators[iNdEx])
+			copy(dAtA[i:], m.ReservedSubdenomExemptCreators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReservedSubdenomExemptCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxMintSchedulesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMintSchedulesPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ReservedSymbols) > 0 {
+		for iNdEx := len(m.ReservedSymbols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedSymbols[iNdEx])
+			copy(dAtA[i:], m.ReservedSymbols[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReservedSymbols[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DenomUnitPatterns) > 0 {
+		for iNdEx := len(m.DenomUnitPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomUnitPatterns[iNdEx])
+			copy(dAtA[i:], m.DenomUnitPatterns[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DenomUnitPatterns[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DenomCreationFeeIsDeposit {
+		i--
+		if m.DenomCreationFeeIsDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreationWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CreationWindowBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxCreationsPerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCreationsPerWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDenomsPerCreator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReservedSubdenoms) > 0 {
+		for iNdEx := len(m.ReservedSubdenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedSubdenoms[iNdEx])
+			copy(dAtA[i:], m.ReservedSubdenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReservedSubdenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -186,6 +388,48 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if len(m.ReservedSubdenoms) > 0 {
+		for _, s := range m.ReservedSubdenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxDenomsPerCreator))
+	}
+	if m.MaxCreationsPerWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxCreationsPerWindow))
+	}
+	if m.CreationWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CreationWindowBlocks))
+	}
+	if m.DenomCreationFeeIsDeposit {
+		n += 2
+	}
+	if len(m.DenomUnitPatterns) > 0 {
+		for _, s := range m.DenomUnitPatterns {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ReservedSymbols) > 0 {
+		for _, s := range m.ReservedSymbols {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxMintSchedulesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxMintSchedulesPerBlock))
+	}
+	if len(m.ReservedSubdenomExemptCreators) > 0 {
+		for _, s := range m.ReservedSubdenomExemptCreators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxMintSchedulesPerDenom != 0 {
+		n += 1 + sovParams(uint64(m.MaxMintSchedulesPerDenom))
+	}
 	return n
 }
 
@@ -277,6 +521,249 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedSubdenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedSubdenoms = append(m.ReservedSubdenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerCreator", wireType)
+			}
+			m.MaxDenomsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreationsPerWindow", wireType)
+			}
+			m.MaxCreationsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCreationsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationWindowBlocks", wireType)
+			}
+			m.CreationWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeIsDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenomCreationFeeIsDeposit = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnitPatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnitPatterns = append(m.DenomUnitPatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedSymbols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedSymbols = append(m.ReservedSymbols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintSchedulesPerBlock", wireType)
+			}
+			m.MaxMintSchedulesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMintSchedulesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedSubdenomExemptCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedSubdenomExemptCreators = append(m.ReservedSubdenomExemptCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintSchedulesPerDenom", wireType)
+			}
+			m.MaxMintSchedulesPerDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMintSchedulesPerDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,130 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// proposal types
+const (
+	ProposalTypeUpdateReservedSubdenoms = "UpdateReservedSubdenoms"
+	ProposalTypeDelistDenom             = "DelistDenom"
+	ProposalTypeSetDenomAdmin           = "SetDenomAdmin"
+	ProposalTypeFreezeDenom             = "FreezeDenom"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateReservedSubdenoms)
+	govtypes.RegisterProposalTypeCodec(&UpdateReservedSubdenomsProposal{}, "osmosis/tokenfactory/reserved-proposal")
+	govtypes.RegisterProposalType(ProposalTypeDelistDenom)
+	govtypes.RegisterProposalTypeCodec(&DelistDenomProposal{}, "osmosis/tokenfactory/delist-proposal")
+	govtypes.RegisterProposalType(ProposalTypeSetDenomAdmin)
+	govtypes.RegisterProposalTypeCodec(&SetDenomAdminProposal{}, "osmosis/tokenfactory/set-admin-proposal")
+	govtypes.RegisterProposalType(ProposalTypeFreezeDenom)
+	govtypes.RegisterProposalTypeCodec(&FreezeDenomProposal{}, "osmosis/tokenfactory/freeze-proposal")
+}
+
+var _ govtypes.Content = &UpdateReservedSubdenomsProposal{}
+
+// NewUpdateReservedSubdenomsProposal creates a proposal to update the reserved subdenom patterns
+// and the creators that are exempt from them
+func NewUpdateReservedSubdenomsProposal(title, description string, addPatterns, removePatterns, addExemptCreators, removeExemptCreators []string) *UpdateReservedSubdenomsProposal {
+	return &UpdateReservedSubdenomsProposal{
+		Title:                title,
+		Description:          description,
+		AddPatterns:          addPatterns,
+		RemovePatterns:       removePatterns,
+		AddExemptCreators:    addExemptCreators,
+		RemoveExemptCreators: removeExemptCreators,
+	}
+}
+
+func (p *UpdateReservedSubdenomsProposal) ProposalRoute() string { return RouterKey }
+func (p *UpdateReservedSubdenomsProposal) ProposalType() string {
+	return ProposalTypeUpdateReservedSubdenoms
+}
+
+func (p *UpdateReservedSubdenomsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return validateReservedSubdenomsUpdate(p.AddPatterns, p.RemovePatterns, p.AddExemptCreators, p.RemoveExemptCreators)
+}
+
+var _ govtypes.Content = &DelistDenomProposal{}
+
+// NewDelistDenomProposal creates a proposal to delist a denom
+func NewDelistDenomProposal(title, description, denom string) *DelistDenomProposal {
+	return &DelistDenomProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+func (p *DelistDenomProposal) ProposalRoute() string { return RouterKey }
+func (p *DelistDenomProposal) ProposalType() string  { return ProposalTypeDelistDenom }
+func (p *DelistDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(p.Denom)
+	return err
+}
+
+var _ govtypes.Content = &SetDenomAdminProposal{}
+
+// NewSetDenomAdminProposal creates a proposal to reassign or remove the admin of a denom
+func NewSetDenomAdminProposal(title, description, denom, newAdmin string) *SetDenomAdminProposal {
+	return &SetDenomAdminProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		NewAdmin:    newAdmin,
+	}
+}
+
+func (p *SetDenomAdminProposal) ProposalRoute() string { return RouterKey }
+func (p *SetDenomAdminProposal) ProposalType() string  { return ProposalTypeSetDenomAdmin }
+func (p *SetDenomAdminProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.NewAdmin != "" {
+		_, err := sdk.AccAddressFromBech32(p.NewAdmin)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+		}
+	}
+
+	_, _, err := DeconstructDenom(p.Denom)
+	return err
+}
+
+var _ govtypes.Content = &FreezeDenomProposal{}
+
+// NewFreezeDenomProposal creates a proposal to freeze or unfreeze a denom
+func NewFreezeDenomProposal(title, description, denom string, frozen bool) *FreezeDenomProposal {
+	return &FreezeDenomProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		Frozen:      frozen,
+	}
+}
+
+func (p *FreezeDenomProposal) ProposalRoute() string { return RouterKey }
+func (p *FreezeDenomProposal) ProposalType() string  { return ProposalTypeFreezeDenom }
+func (p *FreezeDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(p.Denom)
+	return err
+}
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+// TestProposals tests if valid/invalid proposals are properly validated/invalidated
+func TestProposals(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := "factory/" + addr1.String() + "/bitcoin"
+
+	tests := []struct {
+		name       string
+		proposal   govtypes.Content
+		expectPass bool
+	}{
+		{
+			name:       "proper update reserved subdenoms proposal",
+			proposal:   types.NewUpdateReservedSubdenomsProposal("title", "description", []string{"usd*"}, nil, []string{addr1.String()}, nil),
+			expectPass: true,
+		},
+		{
+			name:       "empty update reserved subdenoms proposal",
+			proposal:   types.NewUpdateReservedSubdenomsProposal("title", "description", nil, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name:       "update reserved subdenoms proposal with an empty title",
+			proposal:   types.NewUpdateReservedSubdenomsProposal("", "description", []string{"usd*"}, nil, nil, nil),
+			expectPass: false,
+		},
+		{
+			name:       "proper delist denom proposal",
+			proposal:   types.NewDelistDenomProposal("title", "description", denom),
+			expectPass: true,
+		},
+		{
+			name:       "delist denom proposal with an invalid denom",
+			proposal:   types.NewDelistDenomProposal("title", "description", "bitcoin"),
+			expectPass: false,
+		},
+		{
+			name:       "proper set denom admin proposal",
+			proposal:   types.NewSetDenomAdminProposal("title", "description", denom, addr1.String()),
+			expectPass: true,
+		},
+		{
+			name:       "set denom admin proposal removing the admin",
+			proposal:   types.NewSetDenomAdminProposal("title", "description", denom, ""),
+			expectPass: true,
+		},
+		{
+			name:       "set denom admin proposal with an invalid new admin",
+			proposal:   types.NewSetDenomAdminProposal("title", "description", denom, "admin"),
+			expectPass: false,
+		},
+		{
+			name:       "proper freeze denom proposal",
+			proposal:   types.NewFreezeDenomProposal("title", "description", denom, true),
+			expectPass: true,
+		},
+		{
+			name:       "freeze denom proposal with an empty description",
+			proposal:   types.NewFreezeDenomProposal("title", "", denom, true),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, types.RouterKey, test.proposal.ProposalRoute(), test.name)
+		if test.expectPass {
+			require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
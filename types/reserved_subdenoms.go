@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ReservedSubdenomWildcard matches any sequence of characters in a reserved
+// subdenom pattern.
+const ReservedSubdenomWildcard = "*"
+
+// ValidateReservedSubdenomPattern checks that pattern is a valid reserved
+// subdenom pattern. Apart from the wildcard, a pattern can only contain the
+// characters that are allowed in a subdenom.
+func ValidateReservedSubdenomPattern(pattern string) error {
+	if pattern == "" {
+		return errorsmod.Wrap(ErrInvalidReservedSubdenom, "pattern cannot be empty")
+	}
+	if len(pattern) > MaxSubdenomLength {
+		return errorsmod.Wrapf(ErrInvalidReservedSubdenom, "pattern %s is longer than %d bytes", pattern, MaxSubdenomLength)
+	}
+
+	for _, c := range pattern {
+		isAlphanumeric := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if !isAlphanumeric && !strings.ContainsRune("/:._-"+ReservedSubdenomWildcard, c) {
+			return errorsmod.Wrapf(ErrInvalidReservedSubdenom, "pattern %s contains invalid character %q", pattern, c)
+		}
+	}
+
+	return nil
+}
+
+// MatchReservedSubdenomPattern returns whether subdenom matches pattern. A
+// pattern without a wildcard only matches the same subdenom. Matching ignores
+// case, so that reserving "usdc" also reserves "USDC".
+func MatchReservedSubdenomPattern(pattern, subdenom string) bool {
+	pattern, subdenom = strings.ToLower(pattern), strings.ToLower(subdenom)
+	parts := strings.Split(pattern, ReservedSubdenomWildcard)
+	if len(parts) == 1 {
+		return pattern == subdenom
+	}
+
+	// the first part has to be a prefix and the last part a suffix of the
+	// subdenom, with the parts in between appearing in order.
+	if !strings.HasPrefix(subdenom, parts[0]) {
+		return false
+	}
+	subdenom = subdenom[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(subdenom, part)
+		if i < 0 {
+			return false
+		}
+		subdenom = subdenom[i+len(part):]
+	}
+
+	return len(subdenom) >= len(last) && strings.HasSuffix(subdenom, last)
+}
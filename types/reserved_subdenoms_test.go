@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func TestMatchReservedSubdenomPattern(t *testing.T) {
+	for _, tc := range []struct {
+		pattern  string
+		subdenom string
+		match    bool
+	}{
+		{pattern: "usdc", subdenom: "usdc", match: true},
+		{pattern: "usdc", subdenom: "usdc2", match: false},
+		{pattern: "usdc", subdenom: "uusdc", match: false},
+		{pattern: "usdc", subdenom: "USDC", match: true},
+		{pattern: "USDC", subdenom: "Usdc", match: true},
+		{pattern: "usd*", subdenom: "USDT", match: true},
+		{pattern: "usd*", subdenom: "usd", match: true},
+		{pattern: "usd*", subdenom: "usdt", match: true},
+		{pattern: "usd*", subdenom: "usd/wrapped", match: true},
+		{pattern: "usd*", subdenom: "uusd", match: false},
+		{pattern: "*atom", subdenom: "stuatom", match: true},
+		{pattern: "*atom", subdenom: "atoms", match: false},
+		{pattern: "*usd*", subdenom: "axlusdc", match: true},
+		{pattern: "u*o*", subdenom: "uosmo", match: true},
+		{pattern: "u*sm*o", subdenom: "uosmo", match: true},
+		{pattern: "ab*ba", subdenom: "aba", match: false},
+		{pattern: "*", subdenom: "anything", match: true},
+	} {
+		require.Equal(t, tc.match, types.MatchReservedSubdenomPattern(tc.pattern, tc.subdenom), "pattern %s, subdenom %s", tc.pattern, tc.subdenom)
+	}
+}
+
+func TestValidateReservedSubdenomPattern(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		valid   bool
+	}{
+		{pattern: "usdc", valid: true},
+		{pattern: "usd*", valid: true},
+		{pattern: "ibc/*", valid: true},
+		{pattern: "", valid: false},
+		{pattern: "usd$", valid: false},
+		{pattern: "usd c", valid: false},
+		{pattern: "assadsadsadasdasdsadsadsadsadsadsadsklkadaskkkdasdasedskhanhassyeunganassfnlksdflksafjlkasd", valid: false},
+	} {
+		err := types.ValidateReservedSubdenomPattern(tc.pattern)
+		if tc.valid {
+			require.NoError(t, err, "pattern %s", tc.pattern)
+		} else {
+			require.Error(t, err, "pattern %s", tc.pattern)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateMintSchedulePeriod checks that exactly one of the periods of a mint schedule is set
+func ValidateMintSchedulePeriod(periodBlocks, periodSeconds uint64) error {
+	if (periodBlocks == 0) == (periodSeconds == 0) {
+		return errorsmod.Wrap(ErrInvalidMintSchedule, "exactly one of period blocks and period seconds must be set")
+	}
+	// the periods are added to block heights and times, which must not overflow
+	if periodBlocks > math.MaxInt32 || periodSeconds > math.MaxInt32 {
+		return errorsmod.Wrapf(ErrInvalidMintSchedule, "period must be at most %d", math.MaxInt32)
+	}
+	return nil
+}
+
+// ValidateMintScheduleDecayRate checks that the decay rate of a mint schedule is in [0, 1).
+// An unset decay rate means no decay.
+func ValidateMintScheduleDecayRate(decayRate sdk.Dec) error {
+	if decayRate.IsNil() {
+		return nil
+	}
+	if decayRate.IsNegative() || decayRate.GTE(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidMintSchedule, "decay rate %s must be at least 0 and less than 1", decayRate)
+	}
+	return nil
+}
+
+func (schedule MintSchedule) Validate() error {
+	_, err := sdk.AccAddressFromBech32(schedule.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(schedule.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if !schedule.Amount.IsValid() || schedule.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidMintSchedule, "invalid amount %s", schedule.Amount)
+	}
+
+	err = ValidateMintSchedulePeriod(schedule.PeriodBlocks, schedule.PeriodSeconds)
+	if err != nil {
+		return err
+	}
+
+	return ValidateMintScheduleDecayRate(schedule.DecayRate)
+}
+
+// IsDue returns whether the schedule must be executed in a block with the given height and time
+func (schedule MintSchedule) IsDue(height int64, blockTime time.Time) bool {
+	if schedule.PeriodBlocks != 0 {
+		return height >= schedule.NextHeight
+	}
+	return !blockTime.Before(schedule.NextTime)
+}
+
+// Advance updates the schedule after an execution in a block with the given height and time.
+// The next execution is one period after that block, so that executions missed while the
+// schedule was not visited are dropped rather than caught up. It returns whether the schedule
+// has ended.
+func (schedule *MintSchedule) Advance(height int64, blockTime time.Time) bool {
+	schedule.Skip(height, blockTime)
+
+	if schedule.RemainingMints != 0 {
+		schedule.RemainingMints--
+		if schedule.RemainingMints == 0 {
+			return true
+		}
+	}
+
+	if !schedule.DecayRate.IsNil() && schedule.DecayRate.IsPositive() {
+		decayed := sdk.NewDecFromInt(schedule.Amount.Amount).Mul(sdk.OneDec().Sub(schedule.DecayRate)).TruncateInt()
+		schedule.Amount = sdk.NewCoin(schedule.Amount.Denom, decayed)
+	}
+	return schedule.Amount.IsZero()
+}
+
+// Skip moves the next execution of the schedule to one period after the block with the given
+// height and time, without counting an execution.
+func (schedule *MintSchedule) Skip(height int64, blockTime time.Time) {
+	if schedule.PeriodBlocks != 0 {
+		schedule.NextHeight = height + int64(schedule.PeriodBlocks)
+	} else {
+		schedule.NextTime = blockTime.Add(time.Duration(schedule.PeriodSeconds) * time.Second)
+	}
+}
@@ -0,0 +1,791 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tokenfactory/v1beta1/schedules.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintSchedule is a recurring mint of a denom to a recipient, executed by the
+// module in EndBlock once every period. The period is either a number of
+// blocks or a number of seconds.
+type MintSchedule struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// creator is the admin of the denom that created the schedule. The schedule
+	// is removed when creator is no longer the admin of the denom.
+	Creator   string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// amount is the amount minted at the next execution.
+	Amount        types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	PeriodBlocks  uint64     `protobuf:"varint,5,opt,name=period_blocks,json=periodBlocks,proto3" json:"period_blocks,omitempty" yaml:"period_blocks"`
+	PeriodSeconds uint64     `protobuf:"varint,6,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty" yaml:"period_seconds"`
+	// next_height is the block height of the next execution, if the period is
+	// in blocks.
+	NextHeight int64 `protobuf:"varint,7,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty" yaml:"next_height"`
+	// next_time is the block time of the next execution, if the period is in
+	// seconds.
+	NextTime time.Time `protobuf:"bytes,8,opt,name=next_time,json=nextTime,proto3,stdtime" json:"next_time" yaml:"next_time"`
+	// remaining_mints is the number of executions left before the schedule
+	// ends. Zero means the schedule runs until it is cancelled.
+	RemainingMints uint64 `protobuf:"varint,9,opt,name=remaining_mints,json=remainingMints,proto3" json:"remaining_mints,omitempty" yaml:"remaining_mints"`
+	// decay_rate is the fraction by which amount decreases after every
+	// execution. The schedule ends when amount decays to zero.
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate" yaml:"decay_rate"`
+}
+
+func (m *MintSchedule) Reset()         { *m = MintSchedule{} }
+func (m *MintSchedule) String() string { return proto.CompactTextString(m) }
+func (*MintSchedule) ProtoMessage()    {}
+func (*MintSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deba179412d09ca4, []int{0}
+}
+func (m *MintSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintSchedule.Merge(m, src)
+}
+func (m *MintSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintSchedule proto.InternalMessageInfo
+
+func (m *MintSchedule) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MintSchedule) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MintSchedule) GetPeriodBlocks() uint64 {
+	if m != nil {
+		return m.PeriodBlocks
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetPeriodSeconds() uint64 {
+	if m != nil {
+		return m.PeriodSeconds
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *MintSchedule) GetNextTime() time.Time {
+	if m != nil {
+		return m.NextTime
+	}
+	return time.Time{}
+}
+
+func (m *MintSchedule) GetRemainingMints() uint64 {
+	if m != nil {
+		return m.RemainingMints
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MintSchedule)(nil), "tokenfactory.v1beta1.MintSchedule")
+}
+
+func init() {
+	proto.RegisterFile("tokenfactory/v1beta1/schedules.proto", fileDescriptor_deba179412d09ca4)
+}
+
+var fileDescriptor_deba179412d09ca4 = []byte{
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x53, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0xba, 0xcf, 0x78, 0x1f, 0x0c, 0xd3, 0x4d, 0x59, 0x85, 0xe2, 0xca, 0x20, 0x54, 0x21,
+	0x96, 0x68, 0xe3, 0x80, 0x34, 0x09, 0x09, 0x65, 0x3d, 0x8c, 0x03, 0x97, 0x0c, 0x2e, 0x5c, 0x2a,
+	0x27, 0xf1, 0x52, 0xab, 0x4d, 0x5c, 0xc5, 0x2e, 0xa2, 0xff, 0x62, 0xff, 0x00, 0x7e, 0xce, 0x8e,
+	0x3b, 0x22, 0x0e, 0x06, 0xb5, 0x17, 0xce, 0xf9, 0x05, 0x28, 0x76, 0xda, 0xb5, 0xa7, 0xe4, 0xc9,
+	0xf3, 0x3c, 0xef, 0xeb, 0xf7, 0x7d, 0x1c, 0xf0, 0x52, 0xf2, 0x21, 0xcd, 0x6f, 0x49, 0x2c, 0x79,
+	0x31, 0xf5, 0xbf, 0x9d, 0x47, 0x54, 0x92, 0x73, 0x5f, 0xc4, 0x03, 0x9a, 0x4c, 0x46, 0x54, 0x78,
+	0xe3, 0x82, 0x4b, 0x0e, 0x5b, 0xab, 0x2a, 0xaf, 0x56, 0xb5, 0x5b, 0x29, 0x4f, 0xb9, 0x16, 0xf8,
+	0xd5, 0x9b, 0xd1, 0xb6, 0x51, 0xca, 0x79, 0x3a, 0xa2, 0xbe, 0x46, 0xd1, 0xe4, 0xd6, 0x97, 0x2c,
+	0xa3, 0x42, 0x92, 0x6c, 0x5c, 0x0b, 0xdc, 0x98, 0x8b, 0x8c, 0x0b, 0x3f, 0x22, 0x82, 0x2e, 0x3b,
+	0xc6, 0x9c, 0xe5, 0x86, 0xc7, 0x3f, 0xb6, 0xc0, 0xfe, 0x27, 0x96, 0xcb, 0x9b, 0xfa, 0x10, 0xf0,
+	0x05, 0x68, 0xb2, 0xc4, 0xb1, 0x3a, 0x56, 0x77, 0x33, 0x78, 0x36, 0x53, 0xa8, 0xf9, 0xb1, 0x57,
+	0x2a, 0x64, 0x4f, 0x49, 0x36, 0xba, 0xc4, 0x2c, 0xc1, 0x61, 0x93, 0x25, 0xf0, 0x0d, 0xd8, 0x89,
+	0x0b, 0x4a, 0x24, 0x2f, 0x9c, 0x66, 0xc7, 0xea, 0xda, 0x01, 0x2c, 0x15, 0x3a, 0x34, 0x9a, 0x9a,
+	0xc0, 0xe1, 0x42, 0x02, 0x2f, 0x80, 0x5d, 0xd0, 0x98, 0x8d, 0x19, 0xcd, 0xa5, 0xb3, 0xa1, 0xf5,
+	0xad, 0x52, 0xa1, 0x23, 0xa3, 0x5f, 0x52, 0x38, 0x7c, 0x94, 0xc1, 0x6b, 0xb0, 0x4d, 0x32, 0x3e,
+	0xc9, 0xa5, 0xb3, 0xd9, 0xb1, 0xba, 0x7b, 0x17, 0xa7, 0x9e, 0x19, 0xc4, 0xab, 0x06, 0x59, 0x2c,
+	0xc5, 0xbb, 0xe2, 0x2c, 0x0f, 0x8e, 0xef, 0x15, 0x6a, 0x94, 0x0a, 0x1d, 0x98, 0x7a, 0xc6, 0x86,
+	0xc3, 0xda, 0x0f, 0xdf, 0x83, 0x83, 0x31, 0x2d, 0x18, 0x4f, 0xfa, 0xd1, 0x88, 0xc7, 0x43, 0xe1,
+	0x6c, 0xe9, 0xd9, 0x9c, 0x52, 0xa1, 0x96, 0x71, 0xac, 0xd1, 0x38, 0xdc, 0x37, 0x38, 0xd0, 0x10,
+	0x7e, 0x00, 0x87, 0x35, 0x2f, 0x68, 0xcc, 0xf3, 0x44, 0x38, 0xdb, 0xda, 0x7f, 0x5a, 0x2a, 0x74,
+	0xbc, 0xe6, 0xaf, 0x79, 0x1c, 0xd6, 0xfd, 0x6e, 0x0c, 0x86, 0xef, 0xc0, 0x5e, 0x4e, 0xbf, 0xcb,
+	0xfe, 0x80, 0xb2, 0x74, 0x20, 0x9d, 0x9d, 0x8e, 0xd5, 0xdd, 0x08, 0x4e, 0x4a, 0x85, 0xa0, 0xb1,
+	0xaf, 0x90, 0x38, 0x04, 0x15, 0xba, 0xd6, 0x00, 0x7e, 0x01, 0xb6, 0xe6, 0xaa, 0x4c, 0x9d, 0x5d,
+	0xbd, 0x86, 0xb6, 0x67, 0x02, 0xf7, 0x16, 0x81, 0x7b, 0x9f, 0x17, 0x81, 0x07, 0xcf, 0xeb, 0x3d,
+	0x1c, 0xad, 0x94, 0xad, 0xac, 0xf8, 0xee, 0x0f, 0xb2, 0xc2, 0xdd, 0x0a, 0x57, 0x62, 0x78, 0x05,
+	0x9e, 0x14, 0x34, 0x23, 0x2c, 0x67, 0x79, 0xda, 0xcf, 0x58, 0x2e, 0x85, 0x63, 0xeb, 0x91, 0xda,
+	0xa5, 0x42, 0x27, 0x8b, 0x50, 0xd6, 0x04, 0x38, 0x3c, 0x5c, 0x7e, 0xa9, 0x6e, 0x8b, 0x80, 0x11,
+	0x00, 0x09, 0x8d, 0xc9, 0xb4, 0x5f, 0x10, 0x49, 0x1d, 0xa0, 0x43, 0xbd, 0xaa, 0x0e, 0xf0, 0x5b,
+	0xa1, 0x57, 0x29, 0x93, 0x83, 0x49, 0xe4, 0xc5, 0x3c, 0xf3, 0xeb, 0xeb, 0x67, 0x1e, 0x67, 0x22,
+	0x19, 0xfa, 0x72, 0x3a, 0xa6, 0xc2, 0xeb, 0xd1, 0xb8, 0x54, 0xe8, 0xa9, 0xe9, 0xf6, 0x58, 0x09,
+	0x87, 0xb6, 0x06, 0x21, 0x91, 0xf4, 0x72, 0xf3, 0xdf, 0x4f, 0x64, 0x05, 0xbd, 0xfb, 0x99, 0x6b,
+	0x3d, 0xcc, 0x5c, 0xeb, 0xef, 0xcc, 0xb5, 0xee, 0xe6, 0x6e, 0xe3, 0x61, 0xee, 0x36, 0x7e, 0xcd,
+	0xdd, 0xc6, 0xd7, 0xd7, 0x2b, 0x7d, 0x74, 0x7d, 0x26, 0xce, 0x46, 0x24, 0x12, 0xfe, 0xda, 0x6f,
+	0xa6, 0xfb, 0x45, 0xdb, 0x7a, 0x61, 0x6f, 0xff, 0x0f, 0x00, 0xb5, 0xa6, 0x00, 0x37, 0x83, 0x03,
+	0x00, 0x00,
+}
+
+func (this *MintSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintSchedule)
+	if !ok {
+		that2, ok := that.(MintSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.PeriodBlocks != that1.PeriodBlocks {
+		return false
+	}
+	if this.PeriodSeconds != that1.PeriodSeconds {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if !this.NextTime.Equal(that1.NextTime) {
+		return false
+	}
+	if this.RemainingMints != that1.RemainingMints {
+		return false
+	}
+	if !this.DecayRate.Equal(that1.DecayRate) {
+		return false
+	}
+	return true
+}
+func (m *MintSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSchedules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.RemainingMints != 0 {
+		i = encodeVarintSchedules(dAtA, i, uint64(m.RemainingMints))
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSchedules(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.NextHeight != 0 {
+		i = encodeVarintSchedules(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PeriodSeconds != 0 {
+		i = encodeVarintSchedules(dAtA, i, uint64(m.PeriodSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeriodBlocks != 0 {
+		i = encodeVarintSchedules(dAtA, i, uint64(m.PeriodBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSchedules(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSchedules(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintSchedules(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintSchedules(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedules(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedules(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovSchedules(uint64(m.ID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovSchedules(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSchedules(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSchedules(uint64(l))
+	if m.PeriodBlocks != 0 {
+		n += 1 + sovSchedules(uint64(m.PeriodBlocks))
+	}
+	if m.PeriodSeconds != 0 {
+		n += 1 + sovSchedules(uint64(m.PeriodSeconds))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovSchedules(uint64(m.NextHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextTime)
+	n += 1 + l + sovSchedules(uint64(l))
+	if m.RemainingMints != 0 {
+		n += 1 + sovSchedules(uint64(m.RemainingMints))
+	}
+	l = m.DecayRate.Size()
+	n += 1 + l + sovSchedules(uint64(l))
+	return n
+}
+
+func sovSchedules(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedules(x uint64) (n int) {
+	return sovSchedules(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedules
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodBlocks", wireType)
+			}
+			m.PeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSeconds", wireType)
+			}
+			m.PeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingMints", wireType)
+			}
+			m.RemainingMints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingMints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedules(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedules
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedules(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedules
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedules
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedules
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedules
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedules
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedules        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedules          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedules = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func TestMintScheduleAdvance(t *testing.T) {
+	denom := "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin"
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		desc           string
+		schedule       types.MintSchedule
+		expected       types.MintSchedule
+		expectedEnded  bool
+		expectedIsDue  bool
+		executedHeight int64
+	}{
+		{
+			desc: "period in blocks",
+			schedule: types.MintSchedule{
+				Amount:       sdk.NewInt64Coin(denom, 1000),
+				PeriodBlocks: 10,
+				NextHeight:   100,
+				DecayRate:    sdk.ZeroDec(),
+			},
+			executedHeight: 105,
+			expected: types.MintSchedule{
+				Amount:       sdk.NewInt64Coin(denom, 1000),
+				PeriodBlocks: 10,
+				NextHeight:   115,
+				DecayRate:    sdk.ZeroDec(),
+			},
+			expectedIsDue: true,
+		},
+		{
+			desc: "period in seconds",
+			schedule: types.MintSchedule{
+				Amount:        sdk.NewInt64Coin(denom, 1000),
+				PeriodSeconds: 60,
+				NextTime:      blockTime.Add(time.Second),
+				DecayRate:     sdk.ZeroDec(),
+			},
+			executedHeight: 105,
+			expected: types.MintSchedule{
+				Amount:        sdk.NewInt64Coin(denom, 1000),
+				PeriodSeconds: 60,
+				NextTime:      blockTime.Add(time.Minute),
+				DecayRate:     sdk.ZeroDec(),
+			},
+			expectedIsDue: false,
+		},
+		{
+			desc: "decay rounds down",
+			schedule: types.MintSchedule{
+				Amount:         sdk.NewInt64Coin(denom, 999),
+				PeriodBlocks:   10,
+				RemainingMints: 5,
+				DecayRate:      sdk.NewDecWithPrec(1, 1),
+			},
+			executedHeight: 105,
+			expected: types.MintSchedule{
+				Amount:         sdk.NewInt64Coin(denom, 899),
+				PeriodBlocks:   10,
+				NextHeight:     115,
+				RemainingMints: 4,
+				DecayRate:      sdk.NewDecWithPrec(1, 1),
+			},
+			expectedIsDue: true,
+		},
+		{
+			desc: "last mint ends the schedule",
+			schedule: types.MintSchedule{
+				Amount:         sdk.NewInt64Coin(denom, 1000),
+				PeriodBlocks:   10,
+				RemainingMints: 1,
+				DecayRate:      sdk.ZeroDec(),
+			},
+			executedHeight: 105,
+			expected: types.MintSchedule{
+				Amount:       sdk.NewInt64Coin(denom, 1000),
+				PeriodBlocks: 10,
+				NextHeight:   115,
+				DecayRate:    sdk.ZeroDec(),
+			},
+			expectedEnded: true,
+			expectedIsDue: true,
+		},
+		{
+			desc: "decay to zero ends the schedule",
+			schedule: types.MintSchedule{
+				Amount:       sdk.NewInt64Coin(denom, 1),
+				PeriodBlocks: 10,
+				DecayRate:    sdk.NewDecWithPrec(5, 1),
+			},
+			executedHeight: 105,
+			expected: types.MintSchedule{
+				Amount:       sdk.NewInt64Coin(denom, 0),
+				PeriodBlocks: 10,
+				NextHeight:   115,
+				DecayRate:    sdk.NewDecWithPrec(5, 1),
+			},
+			expectedEnded: true,
+			expectedIsDue: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expectedIsDue, tc.schedule.IsDue(tc.executedHeight, blockTime))
+
+			schedule := tc.schedule
+			ended := schedule.Advance(tc.executedHeight, blockTime)
+			require.Equal(t, tc.expectedEnded, ended)
+			require.True(t, tc.expected.Amount.IsEqual(schedule.Amount))
+			require.Equal(t, tc.expected.NextHeight, schedule.NextHeight)
+			require.Equal(t, tc.expected.NextTime, schedule.NextTime)
+			require.Equal(t, tc.expected.RemainingMints, schedule.RemainingMints)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (checkpoint SnapshotCheckpoint) Validate() error {
+	_, err := sdk.AccAddressFromBech32(checkpoint.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid checkpoint address (%s)", err)
+	}
+
+	if checkpoint.Balance.IsNil() || checkpoint.Balance.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "invalid checkpoint balance %s", checkpoint.Balance)
+	}
+
+	return nil
+}
+
+// ValidateSnapshots checks that the snapshots of a denom have consecutive IDs starting at 1,
+// and that the checkpoints and holders refer to them and to valid accounts.
+func ValidateSnapshots(snapshots []Snapshot, checkpoints []SnapshotCheckpoint, holders []string) error {
+	for i, snapshot := range snapshots {
+		if snapshot.ID != uint64(i+1) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "snapshot id %d must be %d", snapshot.ID, i+1)
+		}
+	}
+
+	seenCheckpoints := map[string]bool{}
+	for _, checkpoint := range checkpoints {
+		if err := checkpoint.Validate(); err != nil {
+			return err
+		}
+
+		if checkpoint.SnapshotID == 0 || checkpoint.SnapshotID > uint64(len(snapshots)) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "checkpoint of unknown snapshot id %d", checkpoint.SnapshotID)
+		}
+
+		key := string(GetSnapshotCheckpointKey(sdk.MustAccAddressFromBech32(checkpoint.Address), checkpoint.SnapshotID))
+		if seenCheckpoints[key] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate checkpoint of %s at snapshot id %d", checkpoint.Address, checkpoint.SnapshotID)
+		}
+		seenCheckpoints[key] = true
+	}
+
+	seenHolders := map[string]bool{}
+	for _, holder := range holders {
+		if seenHolders[holder] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate snapshot holder: %s", holder)
+		}
+		seenHolders[holder] = true
+
+		if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid snapshot holder (%s)", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferFeeBasisPoints is the highest transfer fee, which equals the transferred
+// amount
+const MaxTransferFeeBasisPoints = 10000
+
+func (fee TransferFee) Validate() error {
+	if fee.BasisPoints == 0 || fee.BasisPoints > MaxTransferFeeBasisPoints {
+		return errorsmod.Wrapf(ErrInvalidTransferFee, "basis points must be between 1 and %d, got %d", MaxTransferFeeBasisPoints, fee.BasisPoints)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(fee.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidTransferFee, "invalid recipient address (%s)", err)
+	}
+
+	seen := map[string]bool{}
+	for _, addr := range fee.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTransferFee, "invalid exempt address (%s)", err)
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidTransferFee, "duplicate exempt address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// IsExempt returns whether addr sends and receives without a transfer fee
+func (fee TransferFee) IsExempt(addr sdk.AccAddress) bool {
+	for _, exempt := range fee.ExemptAddresses {
+		if exempt == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Amount returns the transfer fee of a transferred amount, rounded down
+func (fee TransferFee) Amount(amount sdk.Int) sdk.Int {
+	return amount.MulRaw(int64(fee.BasisPoints)).QuoRaw(MaxTransferFeeBasisPoints)
+}
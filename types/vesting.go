@@ -0,0 +1,55 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateVestingTimes checks that the times of a vesting schedule are set and in order
+func ValidateVestingTimes(startTime, cliffTime, endTime time.Time) error {
+	if startTime.IsZero() || cliffTime.IsZero() || endTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidVestingSchedule, "start, cliff and end times must be set")
+	}
+	if cliffTime.Before(startTime) {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "cliff time %s is before start time %s", cliffTime, startTime)
+	}
+	if endTime.Before(cliffTime) {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "end time %s is before cliff time %s", endTime, cliffTime)
+	}
+	return nil
+}
+
+func (schedule VestingSchedule) Validate() error {
+	_, err := sdk.AccAddressFromBech32(schedule.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+
+	if !schedule.Amount.IsValid() || schedule.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "invalid amount %s", schedule.Amount)
+	}
+
+	if schedule.Claimed.IsNil() || schedule.Claimed.IsNegative() || schedule.Claimed.GT(schedule.Amount.Amount) {
+		return errorsmod.Wrapf(ErrInvalidVestingSchedule, "claimed amount must be between 0 and %s", schedule.Amount.Amount)
+	}
+
+	return ValidateVestingTimes(schedule.StartTime, schedule.CliffTime, schedule.EndTime)
+}
+
+// VestedAmount returns the part of the amount of the schedule that is vested at blockTime,
+// including the part that was already claimed.
+func (schedule VestingSchedule) VestedAmount(blockTime time.Time) sdk.Int {
+	switch {
+	case blockTime.Before(schedule.CliffTime):
+		return sdk.ZeroInt()
+	case !blockTime.Before(schedule.EndTime):
+		return schedule.Amount.Amount
+	}
+
+	elapsed := sdk.NewInt(int64(blockTime.Sub(schedule.StartTime)))
+	duration := sdk.NewInt(int64(schedule.EndTime.Sub(schedule.StartTime)))
+	return schedule.Amount.Amount.Mul(elapsed).Quo(duration)
+}
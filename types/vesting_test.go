@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/tokenfactory/types"
+)
+
+func TestVestedAmount(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	linear := types.VestingSchedule{
+		Amount:    sdk.NewInt64Coin("factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin", 1000),
+		StartTime: startTime,
+		CliffTime: startTime,
+		EndTime:   startTime.Add(1000 * time.Second),
+	}
+	cliff := linear
+	cliff.CliffTime = cliff.EndTime
+
+	for _, tc := range []struct {
+		desc      string
+		schedule  types.VestingSchedule
+		blockTime time.Time
+		expected  int64
+	}{
+		{"linear before start", linear, startTime.Add(-time.Second), 0},
+		{"linear at start", linear, startTime, 0},
+		{"linear in between", linear, startTime.Add(250 * time.Second), 250},
+		{"linear rounds down", linear, startTime.Add(250*time.Second + time.Millisecond), 250},
+		{"linear at end", linear, linear.EndTime, 1000},
+		{"linear after end", linear, linear.EndTime.Add(time.Hour), 1000},
+		{"cliff before end", cliff, cliff.EndTime.Add(-time.Second), 0},
+		{"cliff at end", cliff, cliff.EndTime, 1000},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, sdk.NewInt(tc.expected), tc.schedule.VestedAmount(tc.blockTime))
+		})
+	}
+}
+
+func TestValidateVestingTimes(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, types.ValidateVestingTimes(startTime, startTime, startTime.Add(time.Hour)))
+	require.NoError(t, types.ValidateVestingTimes(startTime, startTime.Add(time.Hour), startTime.Add(time.Hour)))
+	require.Error(t, types.ValidateVestingTimes(time.Time{}, startTime, startTime.Add(time.Hour)))
+	require.Error(t, types.ValidateVestingTimes(startTime, startTime.Add(-time.Hour), startTime.Add(time.Hour)))
+	require.Error(t, types.ValidateVestingTimes(startTime, startTime.Add(2*time.Hour), startTime.Add(time.Hour)))
+}